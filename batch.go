@@ -0,0 +1,140 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// FilterValid parses each input and splits them into valid UUIDs and the raw
+// strings that failed validation, preserving input order
+func FilterValid(inputs []string) (valid []ValidatedUUID, dropped []string) {
+	for _, s := range inputs {
+		u, err := Parse(s)
+		if err != nil {
+			dropped = append(dropped, s)
+			continue
+		}
+		valid = append(valid, u)
+	}
+	return valid, dropped
+}
+
+// MergeSets partitions the UUIDs of a and b into those only in a, only in b and
+// in both. Duplicates within each input are ignored and every partition is
+// sorted by byte order.
+func MergeSets(a, b []ValidatedUUID) (onlyA, onlyB, both []ValidatedUUID) {
+	inA, inB := NewSet(a...), NewSet(b...)
+	return inA.Difference(inB).Slice(), inB.Difference(inA).Slice(), inA.Intersect(inB).Slice()
+}
+
+// CanonicalizeStringsInPlace rewrites each element of ss to its canonical
+// lowercase form. Elements that fail validation are left unchanged and
+// reported, by index, in the returned joined error.
+func CanonicalizeStringsInPlace(ss []string) error {
+	var errs []error
+	for i, s := range ss {
+		u, err := Parse(s)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("index %d: %w", i, err))
+			continue
+		}
+		ss[i] = u.UUID.String()
+	}
+	return errors.Join(errs...)
+}
+
+// ParseList parses a free-form list of UUIDs separated by any mix of
+// whitespace and commas, skipping empty tokens. All invalid tokens are
+// reported together, each with its token index and text.
+func ParseList(s string) ([]ValidatedUUID, error) {
+	tokens := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+
+	ids := make([]ValidatedUUID, 0, len(tokens))
+	var errs []error
+	for i, token := range tokens {
+		u, err := Parse(token)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("token %d %q: %w", i, token, err))
+			continue
+		}
+		ids = append(ids, u)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return ids, nil
+}
+
+// VersionHistogram counts how many ids there are of each UUID version
+func VersionHistogram(ids []ValidatedUUID) map[int]int {
+	histogram := make(map[int]int)
+	for _, u := range ids {
+		histogram[u.Version()]++
+	}
+	return histogram
+}
+
+// DominantVersion returns the most common UUID version among ids, preferring
+// the lower version on ties. It returns false if ids is empty.
+func DominantVersion(ids []ValidatedUUID) (int, bool) {
+	if len(ids) == 0 {
+		return 0, false
+	}
+
+	dominant, best := 0, 0
+	for version, count := range VersionHistogram(ids) {
+		if count > best || (count == best && version < dominant) {
+			dominant, best = version, count
+		}
+	}
+	return dominant, true
+}
+
+// MarshalSetBinary encodes ids as a big-endian uint32 count followed by the
+// 16 raw bytes of each UUID
+func MarshalSetBinary(ids []ValidatedUUID) ([]byte, error) {
+	if uint64(len(ids)) > uint64(^uint32(0)) {
+		return nil, fmt.Errorf("too many UUIDs to encode: %d", len(ids))
+	}
+
+	out := make([]byte, 4, 4+16*len(ids))
+	binary.BigEndian.PutUint32(out, uint32(len(ids)))
+	for i, u := range ids {
+		if err := u.Validate(); err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		out = append(out, u.UUID[:]...)
+	}
+	return out, nil
+}
+
+// UnmarshalSetBinary decodes the format produced by MarshalSetBinary
+func UnmarshalSetBinary(b []byte) ([]ValidatedUUID, error) {
+	if len(b) < 4 {
+		return nil, fmt.Errorf("truncated UUID set: missing length prefix")
+	}
+
+	count := binary.BigEndian.Uint32(b)
+	body := b[4:]
+	if uint64(len(body)) != 16*uint64(count) {
+		return nil, fmt.Errorf("truncated UUID set: want %d bytes for %d UUIDs, got %d", 16*uint64(count), count, len(body))
+	}
+
+	ids := make([]ValidatedUUID, count)
+	for i := range ids {
+		u, err := FromGoogleUUID(uuid.UUID(body[16*i : 16*i+16]))
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		ids[i] = u
+	}
+	return ids, nil
+}
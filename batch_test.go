@@ -0,0 +1,185 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterValid(t *testing.T) {
+	a := New()
+	b := New()
+
+	t.Run("mixed input", func(t *testing.T) {
+		valid, dropped := FilterValid([]string{
+			a.String(),
+			"not-a-uuid",
+			"",
+			b.String(),
+			"00000000-0000-0000-0000-000000000000",
+		})
+		assert.Equal(t, []ValidatedUUID{a, b}, valid)
+		assert.Equal(t, []string{"not-a-uuid", "", "00000000-0000-0000-0000-000000000000"}, dropped)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		valid, dropped := FilterValid(nil)
+		assert.Empty(t, valid)
+		assert.Empty(t, dropped)
+	})
+}
+
+func TestMergeSets(t *testing.T) {
+	a := MustParse("00000000-0000-4000-8000-000000000001")
+	b := MustParse("00000000-0000-4000-8000-000000000002")
+	c := MustParse("00000000-0000-4000-8000-000000000003")
+	d := MustParse("00000000-0000-4000-8000-000000000004")
+
+	t.Run("disjoint", func(t *testing.T) {
+		onlyA, onlyB, both := MergeSets([]ValidatedUUID{b, a}, []ValidatedUUID{d, c})
+		assert.Equal(t, []ValidatedUUID{a, b}, onlyA)
+		assert.Equal(t, []ValidatedUUID{c, d}, onlyB)
+		assert.Empty(t, both)
+	})
+
+	t.Run("fully overlapping", func(t *testing.T) {
+		onlyA, onlyB, both := MergeSets([]ValidatedUUID{a, b, a}, []ValidatedUUID{b, a})
+		assert.Empty(t, onlyA)
+		assert.Empty(t, onlyB)
+		assert.Equal(t, []ValidatedUUID{a, b}, both)
+	})
+
+	t.Run("partially overlapping", func(t *testing.T) {
+		onlyA, onlyB, both := MergeSets([]ValidatedUUID{c, a, b, b}, []ValidatedUUID{d, b, c})
+		assert.Equal(t, []ValidatedUUID{a}, onlyA)
+		assert.Equal(t, []ValidatedUUID{d}, onlyB)
+		assert.Equal(t, []ValidatedUUID{b, c}, both)
+	})
+}
+
+func TestCanonicalizeStringsInPlace(t *testing.T) {
+	t.Run("mixed input", func(t *testing.T) {
+		ss := []string{
+			"550E8400-E29B-41D4-A716-446655440000",
+			"not-a-uuid",
+			"{550e8400-e29b-41d4-a716-446655440001}",
+			"",
+		}
+		err := CanonicalizeStringsInPlace(ss)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "index 1")
+		assert.Contains(t, err.Error(), "index 3")
+		assert.NotContains(t, err.Error(), "index 0")
+
+		assert.Equal(t, []string{
+			"550e8400-e29b-41d4-a716-446655440000",
+			"not-a-uuid",
+			"550e8400-e29b-41d4-a716-446655440001",
+			"",
+		}, ss)
+	})
+
+	t.Run("all valid", func(t *testing.T) {
+		ss := []string{"urn:uuid:550E8400-E29B-41D4-A716-446655440000"}
+		require.NoError(t, CanonicalizeStringsInPlace(ss))
+		assert.Equal(t, []string{"550e8400-e29b-41d4-a716-446655440000"}, ss)
+	})
+}
+
+func TestParseList(t *testing.T) {
+	a, b, c := New(), New(), New()
+
+	t.Run("mixed delimiters", func(t *testing.T) {
+		input := " " + a.String() + ",\n\t" + b.String() + " ,, " + c.String() + "\r\n"
+		ids, err := ParseList(input)
+		require.NoError(t, err)
+		assert.Equal(t, []ValidatedUUID{a, b, c}, ids)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		ids, err := ParseList(" ,\n, ")
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("embedded invalid token", func(t *testing.T) {
+		ids, err := ParseList(a.String() + ", nope\n" + b.String())
+		assert.Error(t, err)
+		assert.Nil(t, ids)
+		assert.Contains(t, err.Error(), `token 1 "nope"`)
+	})
+}
+
+func TestVersionHistogram(t *testing.T) {
+	v7 := MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+	v5 := MustParse("886313e1-3b8a-5372-9b90-0c9aee199e5d")
+
+	t.Run("mixed versions", func(t *testing.T) {
+		ids := []ValidatedUUID{New(), v7, New(), v5, New(), v7}
+		assert.Equal(t, map[int]int{4: 3, 5: 1, 7: 2}, VersionHistogram(ids))
+
+		dominant, ok := DominantVersion(ids)
+		assert.True(t, ok)
+		assert.Equal(t, 4, dominant)
+	})
+
+	t.Run("ties prefer the lower version", func(t *testing.T) {
+		dominant, ok := DominantVersion([]ValidatedUUID{v7, New(), v7, New()})
+		assert.True(t, ok)
+		assert.Equal(t, 4, dominant)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, VersionHistogram(nil))
+		_, ok := DominantVersion(nil)
+		assert.False(t, ok)
+	})
+}
+
+func TestSetBinary(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		ids := []ValidatedUUID{New(), New(), New()}
+		data, err := MarshalSetBinary(ids)
+		require.NoError(t, err)
+		assert.Len(t, data, 4+16*len(ids))
+
+		decoded, err := UnmarshalSetBinary(data)
+		require.NoError(t, err)
+		assert.Equal(t, ids, decoded)
+	})
+
+	t.Run("empty set", func(t *testing.T) {
+		data, err := MarshalSetBinary(nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0, 0, 0, 0}, data)
+
+		decoded, err := UnmarshalSetBinary(data)
+		require.NoError(t, err)
+		assert.Empty(t, decoded)
+	})
+
+	t.Run("zero UUID fails", func(t *testing.T) {
+		_, err := MarshalSetBinary([]ValidatedUUID{New(), {}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "index 1")
+
+		data := append([]byte{0, 0, 0, 1}, make([]byte, 16)...)
+		_, err = UnmarshalSetBinary(data)
+		assert.Error(t, err)
+	})
+
+	t.Run("truncated input fails", func(t *testing.T) {
+		data, err := MarshalSetBinary([]ValidatedUUID{New(), New()})
+		require.NoError(t, err)
+
+		for _, n := range []int{0, 3, 4, 20, len(data) - 1} {
+			_, err := UnmarshalSetBinary(data[:n])
+			assert.Error(t, err, "length %d", n)
+			assert.Contains(t, err.Error(), "truncated")
+		}
+
+		_, err = UnmarshalSetBinary(append(data, 0))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,73 @@
+package uuid
+
+import (
+	"encoding/binary"
+
+	"github.com/google/uuid"
+)
+
+// BSON element types and binary subtypes used by the BSON value methods
+const (
+	bsonTypeString    byte = 0x02
+	bsonTypeBinary    byte = 0x05
+	bsonSubtypeUUIDv3 byte = 0x03 // legacy, byte order driver-specific
+	bsonSubtypeUUID   byte = 0x04
+)
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface of
+// go.mongodb.org/mongo-driver/v2, storing the UUID as BSON binary subtype 4
+func (u ValidatedUUID) MarshalBSONValue() (byte, []byte, error) {
+	if err := u.Validate(); err != nil {
+		return 0, nil, validationFailed("BSON marshalling", err)
+	}
+
+	data := make([]byte, 0, 4+1+16)
+	data = binary.LittleEndian.AppendUint32(data, 16)
+	data = append(data, bsonSubtypeUUID)
+	data = append(data, u.UUID[:]...)
+	return bsonTypeBinary, data, nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface of
+// go.mongodb.org/mongo-driver/v2 with validation. Besides binary subtype 4 it
+// accepts the legacy subtype 3, whose bytes are taken as stored, and strings
+// in any form Parse accepts.
+func (u *ValidatedUUID) UnmarshalBSONValue(t byte, data []byte) error {
+	parsed, err := decodeBSONValue(t, data)
+	if err != nil {
+		return validationFailed("BSON unmarshalling", err)
+	}
+
+	*u = parsed
+	return nil
+}
+
+// decodeBSONValue decodes a BSON binary or string value into a UUID
+func decodeBSONValue(t byte, data []byte) (ValidatedUUID, error) {
+	switch t {
+	case bsonTypeBinary:
+		if len(data) < 5 {
+			return ValidatedUUID{}, invalidFormatf("truncated BSON binary")
+		}
+		n := binary.LittleEndian.Uint32(data)
+		subtype, payload := data[4], data[5:]
+		if subtype != bsonSubtypeUUID && subtype != bsonSubtypeUUIDv3 {
+			return ValidatedUUID{}, invalidFormatf("unsupported BSON binary subtype 0x%02x", subtype)
+		}
+		if n != 16 || len(payload) != 16 {
+			return ValidatedUUID{}, invalidFormatf("invalid BSON binary length %d", n)
+		}
+		return FromGoogleUUID(uuid.UUID(payload))
+	case bsonTypeString:
+		if len(data) < 5 {
+			return ValidatedUUID{}, invalidFormatf("truncated BSON string")
+		}
+		n := binary.LittleEndian.Uint32(data)
+		if n == 0 || int(n) != len(data)-4 || data[len(data)-1] != 0 {
+			return ValidatedUUID{}, invalidFormatf("malformed BSON string")
+		}
+		return Parse(string(data[4 : len(data)-1]))
+	default:
+		return ValidatedUUID{}, invalidFormatf("unsupported BSON type 0x%02x", t)
+	}
+}
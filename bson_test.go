@@ -0,0 +1,80 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bsonBinary encodes payload as a BSON binary value of subtype
+func bsonBinary(subtype byte, payload []byte) []byte {
+	data := binary.LittleEndian.AppendUint32(nil, uint32(len(payload)))
+	data = append(data, subtype)
+	return append(data, payload...)
+}
+
+// bsonString encodes s as a BSON string value
+func bsonString(s string) []byte {
+	data := binary.LittleEndian.AppendUint32(nil, uint32(len(s)+1))
+	data = append(data, s...)
+	return append(data, 0)
+}
+
+func TestValidatedUUID_MarshalBSONValue(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	typ, data, err := u.MarshalBSONValue()
+	require.NoError(t, err)
+	assert.Equal(t, bsonTypeBinary, typ)
+	assert.Equal(t, bsonBinary(bsonSubtypeUUID, u.UUID[:]), data)
+
+	_, _, err = ValidatedUUID{}.MarshalBSONValue()
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func TestValidatedUUID_UnmarshalBSONValue(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	valid := []struct {
+		name string
+		typ  byte
+		data []byte
+	}{
+		{"subtype 4", bsonTypeBinary, bsonBinary(bsonSubtypeUUID, u.UUID[:])},
+		{"subtype 3", bsonTypeBinary, bsonBinary(bsonSubtypeUUIDv3, u.UUID[:])},
+		{"string", bsonTypeString, bsonString(u.String())},
+	}
+	for _, tt := range valid {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ValidatedUUID
+			require.NoError(t, got.UnmarshalBSONValue(tt.typ, tt.data))
+			assert.Equal(t, u, got)
+		})
+	}
+
+	invalid := []struct {
+		name string
+		typ  byte
+		data []byte
+		want error
+	}{
+		{"nil UUID", bsonTypeBinary, bsonBinary(bsonSubtypeUUID, make([]byte, 16)), ErrNil},
+		{"short binary", bsonTypeBinary, bsonBinary(bsonSubtypeUUID, u.UUID[:8]), ErrInvalidFormat},
+		{"truncated binary", bsonTypeBinary, []byte{16, 0}, ErrInvalidFormat},
+		{"generic subtype", bsonTypeBinary, bsonBinary(0x00, u.UUID[:]), ErrInvalidFormat},
+		{"invalid string", bsonTypeString, bsonString("invalid"), ErrInvalidFormat},
+		{"empty string", bsonTypeString, bsonString(""), ErrEmpty},
+		{"malformed string", bsonTypeString, bsonString(u.String())[:20], ErrInvalidFormat},
+		{"int32", 0x10, []byte{1, 0, 0, 0}, ErrInvalidFormat},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ValidatedUUID
+			err := got.UnmarshalBSONValue(tt.typ, tt.data)
+			assert.ErrorIs(t, err, tt.want)
+			assert.True(t, got.IsZero())
+		})
+	}
+}
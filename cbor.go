@@ -0,0 +1,53 @@
+package uuid
+
+// CBOR header bytes used by the CBOR methods: tag 37 (UUID, registered with
+// IANA for RFC 8949) and a 16-byte byte string
+const (
+	cborTag1Byte byte = 0xd8 // major type 6, 1-byte tag number follows
+	cborTagUUID  byte = 37
+	cborBytes16  byte = 0x50 // major type 2, length 16
+)
+
+// MarshalCBOR implements the cbor.Marshaler interface of fxamacker/cbor,
+// encoding the UUID as a 16-byte byte string under tag 37
+func (u ValidatedUUID) MarshalCBOR() ([]byte, error) {
+	if err := u.Validate(); err != nil {
+		return nil, validationFailed("CBOR marshalling", err)
+	}
+
+	data := make([]byte, 0, 3+16)
+	data = append(data, cborTag1Byte, cborTagUUID, cborBytes16)
+	return append(data, u.UUID[:]...), nil
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface of fxamacker/cbor
+// with validation. It accepts a 16-byte byte string with or without tag 37.
+func (u *ValidatedUUID) UnmarshalCBOR(data []byte) error {
+	parsed, err := decodeCBOR(data)
+	if err != nil {
+		return validationFailed("CBOR unmarshalling", err)
+	}
+
+	*u = parsed
+	return nil
+}
+
+// decodeCBOR decodes an optionally tagged 16-byte CBOR byte string
+func decodeCBOR(data []byte) (ValidatedUUID, error) {
+	if len(data) == 0 {
+		return ValidatedUUID{}, ErrEmpty
+	}
+	if len(data) >= 2 && data[0] == cborTag1Byte {
+		if data[1] != cborTagUUID {
+			return ValidatedUUID{}, invalidFormatf("unexpected CBOR tag %d", data[1])
+		}
+		data = data[2:]
+	}
+	if len(data) != 1+16 || data[0] != cborBytes16 {
+		return ValidatedUUID{}, invalidFormatf("expected 16-byte CBOR byte string")
+	}
+
+	var b [16]byte
+	copy(b[:], data[1:])
+	return FromGoogleUUID(b)
+}
@@ -0,0 +1,71 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cborClaims struct {
+	Subject ValidatedUUID `cbor:"1,keyasint"`
+}
+
+func TestValidatedUUID_CBOR(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("tagged byte string", func(t *testing.T) {
+		data, err := cbor.Marshal(u)
+		require.NoError(t, err)
+		assert.Equal(t, append([]byte{0xd8, 0x25, 0x50}, u.UUID[:]...), data)
+
+		var tag cbor.Tag
+		require.NoError(t, cbor.Unmarshal(data, &tag))
+		assert.Equal(t, uint64(37), tag.Number)
+		assert.Equal(t, u.UUID[:], tag.Content)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		data, err := cbor.Marshal(cborClaims{Subject: u})
+		require.NoError(t, err)
+
+		var claims cborClaims
+		require.NoError(t, cbor.Unmarshal(data, &claims))
+		assert.Equal(t, u, claims.Subject)
+	})
+
+	t.Run("untagged byte string", func(t *testing.T) {
+		data, err := cbor.Marshal(u.UUID[:])
+		require.NoError(t, err)
+
+		var got ValidatedUUID
+		require.NoError(t, cbor.Unmarshal(data, &got))
+		assert.Equal(t, u, got)
+	})
+
+	t.Run("marshal zero", func(t *testing.T) {
+		_, err := cbor.Marshal(cborClaims{})
+		assert.ErrorIs(t, err, ErrNil)
+	})
+
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"empty", nil, ErrEmpty},
+		{"nil UUID", append([]byte{0xd8, 0x25, 0x50}, make([]byte, 16)...), ErrNil},
+		{"other tag", append([]byte{0xd8, 0x20, 0x50}, u.UUID[:]...), ErrInvalidFormat},
+		{"short byte string", []byte{0xd8, 0x25, 0x42, 1, 2}, ErrInvalidFormat},
+		{"text string", []byte{0x63, 'a', 'b', 'c'}, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ValidatedUUID
+			assert.ErrorIs(t, got.UnmarshalCBOR(tt.data), tt.want)
+			assert.True(t, got.IsZero())
+		})
+	}
+}
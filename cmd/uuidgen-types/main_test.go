@@ -0,0 +1,129 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("declares types and methods", func(t *testing.T) {
+		src, err := generate("models", []string{"UserID", "OrderID"})
+		require.NoError(t, err)
+
+		file, err := parser.ParseFile(token.NewFileSet(), "gen.go", src, 0)
+		require.NoError(t, err)
+		assert.Equal(t, "models", file.Name.Name)
+
+		decls := make(map[string]bool)
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				name := d.Name.Name
+				if d.Recv != nil {
+					recv := d.Recv.List[0].Type
+					if star, ok := recv.(*ast.StarExpr); ok {
+						recv = star.X
+					}
+					name = recv.(*ast.Ident).Name + "." + name
+				}
+				decls[name] = true
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						decls[ts.Name.Name] = true
+					}
+				}
+			}
+		}
+
+		for _, want := range []string{
+			"UserID", "OrderID", "NewUserID", "ParseOrderID", "MustParseUserID",
+			"UserIDFromUUID", "OrderIDFromProto", "UserID.MarshalJSON",
+			"OrderID.UnmarshalJSON", "UserID.MarshalText", "UserID.UnmarshalBinary",
+			"OrderID.Value", "OrderID.Scan", "UserID.ToProto", "UserID.String",
+		} {
+			assert.True(t, decls[want], "missing %s", want)
+		}
+	})
+
+	t.Run("type-checks against the uuid package", func(t *testing.T) {
+		src, err := generate("models", []string{"UserID", "OrderID"})
+		require.NoError(t, err)
+
+		// assertions compiled alongside the output, pinning the method sets
+		// and the signatures forwarded to ValidatedUUID
+		const assertions = `package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+
+	uuid "github.com/alex-held/uuid"
+)
+
+var (
+	_ fmt.Stringer               = UserID{}
+	_ json.Marshaler             = UserID{}
+	_ json.Unmarshaler           = (*UserID)(nil)
+	_ encoding.TextMarshaler     = UserID{}
+	_ encoding.TextUnmarshaler   = (*UserID)(nil)
+	_ encoding.BinaryMarshaler   = OrderID{}
+	_ encoding.BinaryUnmarshaler = (*OrderID)(nil)
+	_ driver.Valuer              = OrderID{}
+	_ sql.Scanner                = (*OrderID)(nil)
+
+	_ func() (*uuid.UUID, error)          = UserID{}.ToProto
+	_ func(*uuid.UUID) (UserID, error)    = UserIDFromProto
+	_ func(uuid.ValidatedUUID) OrderID    = OrderIDFromUUID
+	_ func() uuid.ValidatedUUID           = OrderID{}.UUID
+	_ func(string) (OrderID, error)       = ParseOrderID
+	_ func() error                        = UserID{}.Validate
+)
+`
+
+		fset := token.NewFileSet()
+		var files []*ast.File
+		for name, content := range map[string]string{"gen.go": string(src), "assertions.go": assertions} {
+			file, err := parser.ParseFile(fset, name, content, 0)
+			require.NoError(t, err)
+			files = append(files, file)
+		}
+
+		conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+		_, err = conf.Check("models", fset, files, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects bad input", func(t *testing.T) {
+		_, err := generate("models", nil)
+		assert.Error(t, err)
+		_, err = generate("models", []string{"User-ID"})
+		assert.Error(t, err)
+		_, err = generate("models", []string{"UserID", "UserID"})
+		assert.Error(t, err)
+		_, err = generate("", []string{"UserID"})
+		assert.Error(t, err)
+	})
+}
+
+func TestRun(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "ids.go")
+	require.NoError(t, run([]string{"-type", "UserID, OrderID", "-package", "models", "-output", out}, os.Stderr))
+
+	src, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "// Code generated by uuidgen-types. DO NOT EDIT.")
+	assert.Contains(t, string(src), "type OrderID struct")
+}
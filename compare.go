@@ -0,0 +1,39 @@
+package uuid
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+)
+
+// Compare orders u and other lexicographically by their bytes, returning -1, 0
+// or +1. For v6 and v7 UUIDs this is also creation order.
+func (u ValidatedUUID) Compare(other ValidatedUUID) int {
+	return bytes.Compare(u.UUID[:], other.UUID[:])
+}
+
+// Less reports whether u sorts before other in byte order
+func (u ValidatedUUID) Less(other ValidatedUUID) bool {
+	return u.Compare(other) < 0
+}
+
+// CommonPrefixBits returns the number of leading bits a and b share (0-128)
+func CommonPrefixBits(a, b ValidatedUUID) int {
+	for i := 0; i < len(a.UUID); i++ {
+		if x := a.UUID[i] ^ b.UUID[i]; x != 0 {
+			return i*8 + bits.LeadingZeros8(x)
+		}
+	}
+	return 128
+}
+
+// SameNode reports whether a and b carry the same node ID, suggesting they were
+// minted by the same machine. Both must be v1 or v6 UUIDs.
+func SameNode(a, b ValidatedUUID) (bool, error) {
+	for _, u := range []ValidatedUUID{a, b} {
+		if v := u.Version(); v != 1 && v != 6 {
+			return false, fmt.Errorf("UUID %s is version %d, node IDs require v1 or v6", u.UUID, v)
+		}
+	}
+	return bytes.Equal(a.UUID.NodeID(), b.UUID.NodeID()), nil
+}
@@ -0,0 +1,119 @@
+package uuid
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommonPrefixBits(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want int
+	}{
+		{
+			name: "identical",
+			a:    "550e8400-e29b-41d4-a716-446655440000",
+			b:    "550e8400-e29b-41d4-a716-446655440000",
+			want: 128,
+		},
+		{
+			name: "one bit difference in first byte",
+			a:    "550e8400-e29b-41d4-a716-446655440000",
+			b:    "540e8400-e29b-41d4-a716-446655440000",
+			want: 7,
+		},
+		{
+			name: "differ in last bit",
+			a:    "550e8400-e29b-41d4-a716-446655440000",
+			b:    "550e8400-e29b-41d4-a716-446655440001",
+			want: 127,
+		},
+		{
+			name: "totally different",
+			a:    "00000000-0000-4000-8000-000000000000",
+			b:    "ffffffff-ffff-4fff-bfff-ffffffffffff",
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := MustParse(tt.a)
+			b := MustParse(tt.b)
+			assert.Equal(t, tt.want, CommonPrefixBits(a, b))
+			assert.Equal(t, tt.want, CommonPrefixBits(b, a))
+		})
+	}
+}
+
+func TestValidatedUUID_Compare(t *testing.T) {
+	a := MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+	b := MustParse("01890a5d-ac96-774b-bcce-b302099a8058")
+	c := MustParse("f81d4fae-7dec-11d0-a765-00a0c91e6bf6")
+
+	assert.Equal(t, -1, a.Compare(b))
+	assert.Equal(t, 1, c.Compare(b))
+	assert.Equal(t, 0, a.Compare(a))
+	assert.True(t, a.Less(b))
+	assert.False(t, b.Less(a))
+	assert.False(t, a.Less(a))
+
+	t.Run("sorts with slices.SortFunc", func(t *testing.T) {
+		ids := []ValidatedUUID{c, b, a}
+		slices.SortFunc(ids, ValidatedUUID.Compare)
+		assert.Equal(t, []ValidatedUUID{a, b, c}, ids)
+	})
+
+	t.Run("v7 byte order is creation order", func(t *testing.T) {
+		first := NewV7()
+		second := NewV7()
+		assert.True(t, first.Less(second))
+	})
+}
+
+func TestSameNode(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{
+			name: "v1 sharing a node",
+			a:    "f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+			b:    "0a1b2c3d-7dec-11d0-8123-00a0c91e6bf6",
+			want: true,
+		},
+		{
+			name: "v1 with different nodes",
+			a:    "f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+			b:    "f81d4fae-7dec-11d0-a765-00a0c91e6bf7",
+			want: false,
+		},
+		{
+			name: "v1 and v6 sharing a node",
+			a:    "f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+			b:    "1d07decf-81d4-6fae-a765-00a0c91e6bf6",
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SameNode(MustParse(tt.a), MustParse(tt.b))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("rejects other versions", func(t *testing.T) {
+		v1 := MustParse("f81d4fae-7dec-11d0-a765-00a0c91e6bf6")
+		_, err := SameNode(v1, MustParse("550e8400-e29b-41d4-a716-446655440000"))
+		assert.Error(t, err)
+		_, err = SameNode(MustParse("550e8400-e29b-41d4-a716-446655440000"), v1)
+		assert.Error(t, err)
+	})
+}
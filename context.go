@@ -0,0 +1,31 @@
+package uuid
+
+import "context"
+
+// requestIDKey is the context key under which the request UUID is stored
+type requestIDKey struct{}
+
+// NewContext returns a copy of ctx carrying u as the correlation (request)
+// UUID. Zero UUIDs are rejected and ctx is returned unchanged.
+func NewContext(ctx context.Context, u ValidatedUUID) context.Context {
+	if u.IsZero() {
+		return ctx
+	}
+	return context.WithValue(ctx, requestIDKey{}, u)
+}
+
+// FromContext returns the correlation UUID stored in ctx by NewContext, if any
+func FromContext(ctx context.Context) (ValidatedUUID, bool) {
+	u, ok := ctx.Value(requestIDKey{}).(ValidatedUUID)
+	return u, ok
+}
+
+// WithRequestID is equivalent to NewContext
+func WithRequestID(ctx context.Context, id ValidatedUUID) context.Context {
+	return NewContext(ctx, id)
+}
+
+// RequestIDFrom is equivalent to FromContext
+func RequestIDFrom(ctx context.Context) (ValidatedUUID, bool) {
+	return FromContext(ctx)
+}
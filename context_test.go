@@ -0,0 +1,59 @@
+package uuid
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestIDContext(t *testing.T) {
+	t.Run("present", func(t *testing.T) {
+		id := New()
+		ctx := WithRequestID(context.Background(), id)
+
+		got, ok := RequestIDFrom(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, id, got)
+	})
+
+	t.Run("absent", func(t *testing.T) {
+		got, ok := RequestIDFrom(context.Background())
+		assert.False(t, ok)
+		assert.True(t, got.IsZero())
+	})
+
+	t.Run("zero UUID leaves context unchanged", func(t *testing.T) {
+		ctx := context.Background()
+		assert.Equal(t, ctx, WithRequestID(ctx, ValidatedUUID{}))
+
+		_, ok := RequestIDFrom(WithRequestID(ctx, ValidatedUUID{}))
+		assert.False(t, ok)
+	})
+
+	t.Run("zero UUID keeps existing request ID", func(t *testing.T) {
+		id := New()
+		ctx := WithRequestID(WithRequestID(context.Background(), id), ValidatedUUID{})
+
+		got, ok := RequestIDFrom(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, id, got)
+	})
+}
+
+func TestNewContext(t *testing.T) {
+	id := New()
+	ctx := NewContext(context.Background(), id)
+
+	got, ok := FromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, id, got)
+
+	// the request ID helpers share the same key
+	got, ok = RequestIDFrom(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, id, got)
+
+	_, ok = FromContext(NewContext(context.Background(), ValidatedUUID{}))
+	assert.False(t, ok)
+}
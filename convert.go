@@ -0,0 +1,201 @@
+package uuid
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// int64Marker tags the leading bytes of UUIDs produced by FromInt64
+var int64Marker = [6]byte{'i', 'n', 't', '6', '4', 0x00}
+
+// FromInt64 deterministically maps n to a v8 UUID with the layout:
+//
+//	bytes 0-5:  the ASCII marker "int64\x00"
+//	byte 6:     version 8 (0x80)
+//	byte 7:     most significant byte of n (big-endian)
+//	byte 8:     RFC 4122 variant (0x80)
+//	bytes 9-15: remaining 7 bytes of n (big-endian)
+func FromInt64(n int64) ValidatedUUID {
+	var u uuid.UUID
+	v := uint64(n)
+
+	copy(u[0:6], int64Marker[:])
+	u[6] = 0x80
+	u[7] = byte(v >> 56)
+	u[8] = 0x80
+	for i := 0; i < 7; i++ {
+		u[15-i] = byte(v >> (8 * i))
+	}
+	return ValidatedUUID{UUID: u}
+}
+
+// ToInt64 decodes a UUID produced by FromInt64 back into its integer
+func (u ValidatedUUID) ToInt64() (int64, error) {
+	if !bytes.Equal(u.UUID[0:6], int64Marker[:]) || u.UUID[6] != 0x80 || u.UUID[8] != 0x80 {
+		return 0, fmt.Errorf("UUID %s was not produced by FromInt64", u.UUID)
+	}
+
+	v := uint64(u.UUID[7]) << 56
+	for i := 0; i < 7; i++ {
+		v |= uint64(u.UUID[15-i]) << (8 * i)
+	}
+	return int64(v), nil
+}
+
+// decimalDigits is the width of the largest 128-bit value in decimal
+const decimalDigits = 39
+
+// Decimal returns the UUID as an unsigned 128-bit integer, zero-padded to 39 digits
+func (u ValidatedUUID) Decimal() string {
+	return fmt.Sprintf("%0*s", decimalDigits, new(big.Int).SetBytes(u.UUID[:]).String())
+}
+
+// ParseDecimal parses a decimal string produced by Decimal back into a ValidatedUUID
+func ParseDecimal(s string) (ValidatedUUID, error) {
+	if s == "" {
+		return ValidatedUUID{}, ErrEmpty
+	}
+	if len(s) > decimalDigits {
+		return ValidatedUUID{}, invalidFormatf("decimal form exceeds %d digits", decimalDigits)
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return ValidatedUUID{}, invalidFormatf("invalid digit %q at position %d", s[i], i)
+		}
+	}
+
+	n, _ := new(big.Int).SetString(s, 10)
+	if n.BitLen() > 128 {
+		return ValidatedUUID{}, invalidFormatf("decimal value exceeds 128 bits")
+	}
+
+	var u uuid.UUID
+	n.FillBytes(u[:])
+	return FromGoogleUUID(u)
+}
+
+// Complement returns the bitwise NOT of all 16 bytes. It is a raw transform:
+// the result generally has no meaningful version or variant bits, and the
+// complement of the max UUID is the zero value. Complement is an involution,
+// so u.Complement().Complement() == u.
+func (u ValidatedUUID) Complement() ValidatedUUID {
+	var c ValidatedUUID
+	for i, b := range u.UUID {
+		c.UUID[i] = ^b
+	}
+	return c
+}
+
+// SwapEndian reverses the byte order of the first three fields (time_low,
+// time_mid and time_hi_and_version), converting between the RFC 4122 layout
+// and the little-endian layout used by Microsoft GUIDs
+func (u ValidatedUUID) SwapEndian() ValidatedUUID {
+	s := u
+	s.UUID[0], s.UUID[1], s.UUID[2], s.UUID[3] = u.UUID[3], u.UUID[2], u.UUID[1], u.UUID[0]
+	s.UUID[4], s.UUID[5] = u.UUID[5], u.UUID[4]
+	s.UUID[6], s.UUID[7] = u.UUID[7], u.UUID[6]
+	return s
+}
+
+// WithSwappedDisplay returns the canonical form alongside the byte-swapped
+// form, for disambiguating GUIDs of unknown endianness in diagnostics
+func (u ValidatedUUID) WithSwappedDisplay() string {
+	return fmt.Sprintf("%s (byte-swapped: %s)", u.UUID, u.SwapEndian().UUID)
+}
+
+// DCEBytes returns the UUID in the DCE 1.1 binary layout as marshalled by
+// DCE/RPC with little-endian NDR. The time_low (4 bytes), time_mid (2 bytes)
+// and time_hi_and_version (2 bytes) fields are little-endian, while
+// clock_seq and node keep the byte order of the RFC 4122 canonical bytes.
+func (u ValidatedUUID) DCEBytes() ([16]byte, error) {
+	if err := u.Validate(); err != nil {
+		return [16]byte{}, err
+	}
+	return u.SwapEndian().UUID, nil
+}
+
+// FromDCEBytes creates a ValidatedUUID from the DCE 1.1 binary layout produced by DCEBytes
+func FromDCEBytes(b [16]byte) (ValidatedUUID, error) {
+	dce, err := FromGoogleUUID(b)
+	if err != nil {
+		return ValidatedUUID{}, err
+	}
+	return dce.SwapEndian(), nil
+}
+
+// FromHexHalves combines two 16-character hex strings holding the high and low
+// 8 bytes of a UUID (big-endian) into a ValidatedUUID
+func FromHexHalves(hi, lo string) (ValidatedUUID, error) {
+	var u uuid.UUID
+	if err := decodeHexHalf(u[:8], hi); err != nil {
+		return ValidatedUUID{}, fmt.Errorf("invalid high half: %w", err)
+	}
+	if err := decodeHexHalf(u[8:], lo); err != nil {
+		return ValidatedUUID{}, fmt.Errorf("invalid low half: %w", err)
+	}
+	return FromGoogleUUID(u)
+}
+
+// HexHalves returns the high and low 8 bytes of the UUID as 16-character lowercase hex strings
+func (u ValidatedUUID) HexHalves() (hi, lo string) {
+	return hex.EncodeToString(u.UUID[:8]), hex.EncodeToString(u.UUID[8:])
+}
+
+// decodeHexHalf decodes a 16-character hex string into dst
+func decodeHexHalf(dst []byte, s string) error {
+	if len(s) != 16 {
+		return fmt.Errorf("want 16 hex characters, got %d", len(s))
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err
+}
+
+// FromAny converts the common representations of a UUID into a ValidatedUUID:
+// ValidatedUUID, string, []byte (text or raw 16 bytes), [16]byte, uuid.UUID,
+// *UUID, *UUIDBytes, *anypb.Any, fmt.Stringer and driver.Valuer (whose value is converted in turn).
+func FromAny(v interface{}) (ValidatedUUID, error) {
+	switch x := v.(type) {
+	case nil:
+		return ValidatedUUID{}, ErrNil
+	case ValidatedUUID:
+		return x, x.Validate()
+	case *ValidatedUUID:
+		if x == nil {
+			return ValidatedUUID{}, ErrNil
+		}
+		return *x, x.Validate()
+	case string:
+		return Parse(x)
+	case []byte:
+		return ParseBytes(x)
+	case [16]byte:
+		return FromGoogleUUID(x)
+	case uuid.UUID:
+		return FromGoogleUUID(x)
+	case *UUID:
+		return FromProto(x)
+	case *UUIDBytes:
+		return FromProtoBytes(x)
+	case *anypb.Any:
+		return FromAnyProto(x)
+	case driver.Valuer:
+		value, err := x.Value()
+		if err != nil {
+			return ValidatedUUID{}, fmt.Errorf("failed to read value of %T: %w", v, err)
+		}
+		if _, ok := value.(driver.Valuer); ok {
+			return ValidatedUUID{}, fmt.Errorf("cannot convert %T to UUID: value is another driver.Valuer", v)
+		}
+		return FromAny(value)
+	case fmt.Stringer:
+		return Parse(x.String())
+	default:
+		return ValidatedUUID{}, fmt.Errorf("cannot convert %T to UUID", v)
+	}
+}
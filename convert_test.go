@@ -0,0 +1,256 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromInt64(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int64
+	}{
+		{name: "zero", n: 0},
+		{name: "one", n: 1},
+		{name: "negative", n: -42},
+		{name: "max", n: math.MaxInt64},
+		{name: "min", n: math.MinInt64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := FromInt64(tt.n)
+			require.NoError(t, u.Validate())
+			assert.Equal(t, 8, int(u.UUID.Version()))
+
+			n, err := u.ToInt64()
+			require.NoError(t, err)
+			assert.Equal(t, tt.n, n)
+
+			parsed, err := Parse(u.String())
+			require.NoError(t, err)
+			assert.Equal(t, u, parsed)
+		})
+	}
+
+	t.Run("deterministic", func(t *testing.T) {
+		assert.Equal(t, FromInt64(12345), FromInt64(12345))
+		assert.NotEqual(t, FromInt64(12345), FromInt64(12346))
+	})
+
+	t.Run("foreign UUID fails", func(t *testing.T) {
+		_, err := New().ToInt64()
+		assert.Error(t, err)
+	})
+}
+
+func TestValidatedUUID_Decimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		uuid    string
+		decimal string
+	}{
+		{
+			name:    "smallest non-nil",
+			uuid:    "00000000-0000-0000-0000-000000000001",
+			decimal: "000000000000000000000000000000000000001",
+		},
+		{
+			name:    "max",
+			uuid:    "ffffffff-ffff-ffff-ffff-ffffffffffff",
+			decimal: "340282366920938463463374607431768211455",
+		},
+		{
+			name:    "example",
+			uuid:    "550e8400-e29b-41d4-a716-446655440000",
+			decimal: "113059749145936325402354257176981405696",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := MustParse(tt.uuid)
+			assert.Equal(t, tt.decimal, u.Decimal())
+			assert.Len(t, u.Decimal(), 39)
+
+			parsed, err := ParseDecimal(tt.decimal)
+			require.NoError(t, err)
+			assert.Equal(t, u, parsed)
+		})
+	}
+
+	t.Run("unpadded input", func(t *testing.T) {
+		parsed, err := ParseDecimal("1")
+		require.NoError(t, err)
+		assert.Equal(t, "00000000-0000-0000-0000-000000000001", parsed.String())
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		for _, s := range []string{
+			"",
+			"0",
+			"000000000000000000000000000000000000000",
+			"340282366920938463463374607431768211456",
+			"0340282366920938463463374607431768211455",
+			"12a4",
+			"-1",
+		} {
+			_, err := ParseDecimal(s)
+			assert.Error(t, err, s)
+		}
+	})
+}
+
+func TestValidatedUUID_Complement(t *testing.T) {
+	t.Run("known value", func(t *testing.T) {
+		u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+		assert.Equal(t, "aaf17bff-1d64-be2b-58e9-bb99aabbffff", u.Complement().String())
+	})
+
+	t.Run("involution", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			u := New()
+			assert.NotEqual(t, u, u.Complement())
+			assert.Equal(t, u, u.Complement().Complement())
+		}
+	})
+
+	t.Run("max complements to zero", func(t *testing.T) {
+		assert.True(t, MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff").Complement().IsZero())
+	})
+}
+
+func TestValidatedUUID_SwapEndian(t *testing.T) {
+	u := MustParse("00112233-4455-6677-8899-aabbccddeeff")
+	assert.Equal(t, "33221100-5544-7766-8899-aabbccddeeff", u.SwapEndian().String())
+	assert.Equal(t, u, u.SwapEndian().SwapEndian())
+}
+
+func TestValidatedUUID_WithSwappedDisplay(t *testing.T) {
+	u := MustParse("00112233-4455-6677-8899-aabbccddeeff")
+	display := u.WithSwappedDisplay()
+	assert.Contains(t, display, "00112233-4455-6677-8899-aabbccddeeff")
+	assert.Contains(t, display, "33221100-5544-7766-8899-aabbccddeeff")
+	assert.Equal(t, "00112233-4455-6677-8899-aabbccddeeff (byte-swapped: 33221100-5544-7766-8899-aabbccddeeff)", display)
+}
+
+func TestValidatedUUID_DCEBytes(t *testing.T) {
+	// The RFC 4122 example UUID as marshalled by DCE/RPC on a little-endian host
+	u := MustParse("f81d4fae-7dec-11d0-a765-00a0c91e6bf6")
+	dce := [16]byte{
+		0xae, 0x4f, 0x1d, 0xf8,
+		0xec, 0x7d,
+		0xd0, 0x11,
+		0xa7, 0x65,
+		0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6,
+	}
+
+	t.Run("to DCE", func(t *testing.T) {
+		b, err := u.DCEBytes()
+		require.NoError(t, err)
+		assert.Equal(t, dce, b)
+	})
+
+	t.Run("from DCE", func(t *testing.T) {
+		parsed, err := FromDCEBytes(dce)
+		require.NoError(t, err)
+		assert.Equal(t, u, parsed)
+	})
+
+	t.Run("zero fails", func(t *testing.T) {
+		_, err := ValidatedUUID{}.DCEBytes()
+		assert.Error(t, err)
+
+		_, err = FromDCEBytes([16]byte{})
+		assert.Error(t, err)
+	})
+}
+
+func TestHexHalves(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+		hi, lo := u.HexHalves()
+		assert.Equal(t, "550e8400e29b41d4", hi)
+		assert.Equal(t, "a716446655440000", lo)
+
+		parsed, err := FromHexHalves(hi, lo)
+		require.NoError(t, err)
+		assert.Equal(t, u, parsed)
+	})
+
+	t.Run("uppercase halves", func(t *testing.T) {
+		parsed, err := FromHexHalves("550E8400E29B41D4", "A716446655440000")
+		require.NoError(t, err)
+		assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", parsed.String())
+	})
+
+	t.Run("malformed halves", func(t *testing.T) {
+		tests := []struct {
+			name, hi, lo, want string
+		}{
+			{name: "short high", hi: "550e8400", lo: "a716446655440000", want: "high half"},
+			{name: "long low", hi: "550e8400e29b41d4", lo: "a71644665544000000", want: "low half"},
+			{name: "non-hex", hi: "550e8400e29b41zz", lo: "a716446655440000", want: "high half"},
+			{name: "nil result", hi: "0000000000000000", lo: "0000000000000000", want: "nil/zero"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := FromHexHalves(tt.hi, tt.lo)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.want)
+			})
+		}
+	})
+}
+
+type anyStringer string
+
+func (s anyStringer) String() string { return string(s) }
+
+type anyValuer string
+
+func (v anyValuer) Value() (driver.Value, error) { return string(v), nil }
+
+func TestFromAny(t *testing.T) {
+	want := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "ValidatedUUID", value: want},
+		{name: "*ValidatedUUID", value: &want},
+		{name: "string", value: "550e8400-e29b-41d4-a716-446655440000"},
+		{name: "text bytes", value: []byte("550e8400-e29b-41d4-a716-446655440000")},
+		{name: "raw bytes", value: want.UUID[:]},
+		{name: "array", value: [16]byte(want.UUID)},
+		{name: "uuid.UUID", value: want.UUID},
+		{name: "proto", value: &UUID{Val: "550e8400-e29b-41d4-a716-446655440000"}},
+		{name: "proto bytes", value: &UUIDBytes{Val: want.UUID[:]}},
+		{name: "stringer", value: anyStringer("550e8400-e29b-41d4-a716-446655440000")},
+		{name: "valuer", value: anyValuer("550e8400-e29b-41d4-a716-446655440000")},
+		{name: "NullUUID", value: NullUUID{UUID: want, Valid: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromAny(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+
+	t.Run("invalid values fail", func(t *testing.T) {
+		for _, v := range []interface{}{
+			nil, ValidatedUUID{}, (*ValidatedUUID)(nil), "", "nope", uuid.Nil,
+			[16]byte{}, (*UUID)(nil), NullUUID{}, anyStringer("nope"), 42,
+		} {
+			_, err := FromAny(v)
+			assert.Error(t, err, "value %#v", v)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package uuid
+
+import (
+	"fmt"
+	"io"
+)
+
+// DedupeWriter writes UUIDs to an underlying io.Writer one per line, silently
+// skipping any it has already written. Every distinct UUID is remembered, so
+// memory grows with the number of distinct values seen. It is not safe for
+// concurrent use.
+type DedupeWriter struct {
+	w    io.Writer
+	seen Set
+}
+
+// NewDedupeWriter creates a DedupeWriter writing to w
+func NewDedupeWriter(w io.Writer) *DedupeWriter {
+	return &DedupeWriter{w: w}
+}
+
+// Write writes u followed by a newline unless it was written before
+func (d *DedupeWriter) Write(u ValidatedUUID) error {
+	if err := u.Validate(); err != nil {
+		return err
+	}
+	if d.seen.Contains(u) {
+		return nil
+	}
+
+	if _, err := io.WriteString(d.w, u.String()+"\n"); err != nil {
+		return fmt.Errorf("failed to write UUID: %w", err)
+	}
+	d.seen.Add(u)
+	return nil
+}
+
+// Seen returns the number of distinct UUIDs written so far
+func (d *DedupeWriter) Seen() int {
+	return d.seen.Len()
+}
@@ -0,0 +1,44 @@
+package uuid
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestDedupeWriter(t *testing.T) {
+	t.Run("writes each UUID once", func(t *testing.T) {
+		a, b, c := New(), New(), New()
+		var buf bytes.Buffer
+		w := NewDedupeWriter(&buf)
+
+		for _, u := range []ValidatedUUID{a, b, a, c, b, a} {
+			require.NoError(t, w.Write(u))
+		}
+
+		assert.Equal(t, strings.Join([]string{a.String(), b.String(), c.String()}, "\n")+"\n", buf.String())
+		assert.Equal(t, 3, w.Seen())
+	})
+
+	t.Run("zero UUID fails", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.Error(t, NewDedupeWriter(&buf).Write(ValidatedUUID{}))
+		assert.Zero(t, buf.Len())
+	})
+
+	t.Run("failed write is not remembered", func(t *testing.T) {
+		w := NewDedupeWriter(failingWriter{})
+		assert.Error(t, w.Write(New()))
+		assert.Zero(t, w.Seen())
+	})
+}
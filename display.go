@@ -0,0 +1,174 @@
+package uuid
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// crockfordAlphabet is Crockford's base32 alphabet, which omits I, L, O and U
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+var crockfordEncoding = base32.NewEncoding(crockfordAlphabet).WithPadding(base32.NoPadding)
+
+// crockfordAliases maps characters commonly confused with alphabet members
+var crockfordAliases = strings.NewReplacer("O", "0", "I", "1", "L", "1")
+
+// ColorHex returns a stable #RRGGBB color derived from the UUID, suitable for
+// UI avatars. The same UUID always yields the same color. Hue spans the full
+// wheel while saturation (50-79%) and lightness (40-59%) are constrained to
+// avoid near-white, near-black and washed-out colors.
+func (u ValidatedUUID) ColorHex() string {
+	h := u.hash64()
+	hue := float64(h%360) / 360
+	saturation := float64(50+(h>>16)%30) / 100
+	lightness := float64(40+(h>>32)%20) / 100
+
+	r, g, b := hslToRGB(hue, saturation, lightness)
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// hslToRGB converts HSL components in [0, 1] to 8-bit RGB
+func hslToRGB(h, s, l float64) (uint8, uint8, uint8) {
+	q := l * (1 + s)
+	if l >= 0.5 {
+		q = l + s - l*s
+	}
+	p := 2*l - q
+
+	channel := func(t float64) uint8 {
+		switch {
+		case t < 0:
+			t++
+		case t > 1:
+			t--
+		}
+		var v float64
+		switch {
+		case t < 1.0/6:
+			v = p + (q-p)*6*t
+		case t < 1.0/2:
+			v = q
+		case t < 2.0/3:
+			v = p + (q-p)*(2.0/3-t)*6
+		default:
+			v = p
+		}
+		return uint8(math.Round(v * 255))
+	}
+
+	return channel(h + 1.0/3), channel(h), channel(h - 1.0/3)
+}
+
+// DisplayID returns a 27-character form for humans to read aloud or type: the
+// 26-character Crockford base32 encoding followed by a Luhn mod 32 check
+// character, which detects any single mistyped character
+func (u ValidatedUUID) DisplayID() string {
+	encoded := crockfordEncoding.EncodeToString(u.UUID[:])
+	return encoded + string(crockfordAlphabet[luhnCheck32(encoded)])
+}
+
+// ParseDisplayID parses a string produced by DisplayID, verifying its check
+// character. Input is case-insensitive and accepts O for 0 and I or L for 1.
+func ParseDisplayID(s string) (ValidatedUUID, error) {
+	if s == "" {
+		return ValidatedUUID{}, ErrEmpty
+	}
+
+	normalized := crockfordAliases.Replace(strings.ToUpper(s))
+	if len(normalized) != 27 {
+		return ValidatedUUID{}, fmt.Errorf("invalid display ID length %d, want 27", len(normalized))
+	}
+	for i := 0; i < len(normalized); i++ {
+		if strings.IndexByte(crockfordAlphabet, normalized[i]) < 0 {
+			return ValidatedUUID{}, fmt.Errorf("invalid display ID character %q at position %d", s[i], i)
+		}
+	}
+
+	encoded, check := normalized[:26], normalized[26]
+	if crockfordAlphabet[luhnCheck32(encoded)] != check {
+		return ValidatedUUID{}, fmt.Errorf("display ID check character mismatch")
+	}
+
+	raw, err := crockfordEncoding.DecodeString(encoded)
+	if err != nil || crockfordEncoding.EncodeToString(raw) != encoded {
+		return ValidatedUUID{}, fmt.Errorf("invalid display ID encoding")
+	}
+	u, err := uuid.FromBytes(raw)
+	if err != nil {
+		return ValidatedUUID{}, fmt.Errorf("invalid display ID encoding: %w", err)
+	}
+	return FromGoogleUUID(u)
+}
+
+// luhnCheck32 computes the Luhn mod 32 check digit of s over crockfordAlphabet
+func luhnCheck32(s string) int {
+	const n = len(crockfordAlphabet)
+	factor, sum := 2, 0
+	for i := len(s) - 1; i >= 0; i-- {
+		addend := factor * strings.IndexByte(crockfordAlphabet, s[i])
+		if factor == 2 {
+			factor = 1
+		} else {
+			factor = 2
+		}
+		sum += addend/n + addend%n
+	}
+	return (n - sum%n) % n
+}
+
+// fingerprintEmoji is the fixed set used by EmojiFingerprint. Each entry is a
+// single code point so fingerprints have exactly one rune per emoji. The
+// order must never change, or existing fingerprints would change with it.
+var fingerprintEmoji = []rune("🐶🐱🐭🐹🐰🦊🐻🐼🐨🐯🦁🐮🐷🐸🐵🐔🐧🐦🐤🦆🦅🦉🦇🐺🐗🐴🦄🐝🐛🦋🐌🐞🐜🦂🐢🐍🦎🐙🦑🦐🦀🐡🐠🐟🐬🐳🦈🐊🐅🐆🦓🦍🐘🦏🐪🐫🦒🦘🐃🐂🐄🐎🐖🐏")
+
+// EmojiFingerprint returns a stable sequence of n emoji derived from the UUID,
+// for at-a-glance comparison by humans only; it is not a security check. It
+// returns an empty string if n is not positive.
+func (u ValidatedUUID) EmojiFingerprint(n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	var block [sha256.Size]byte
+	var input [len(u.UUID) + 8]byte
+	copy(input[:], u.UUID[:])
+	for i := 0; i < n; i++ {
+		if i%sha256.Size == 0 {
+			binary.BigEndian.PutUint64(input[len(u.UUID):], uint64(i/sha256.Size))
+			block = sha256.Sum256(input[:])
+		}
+		b.WriteRune(fingerprintEmoji[int(block[i%sha256.Size])%len(fingerprintEmoji)])
+	}
+	return b.String()
+}
+
+// redactedVisible is the number of leading hex digits Redacted leaves visible
+const redactedVisible = 6
+
+// Short returns the first 8 hex digits of the canonical form, for log lines
+// where the full identifier is too noisy. It is not unique and ignores
+// DefaultStringFormat.
+func (u ValidatedUUID) Short() string {
+	return u.UUID.String()[:8]
+}
+
+// Redacted returns the canonical form with all but the first 6 hex digits
+// masked, such as 550e84**-****-****-****-************, for user-facing
+// messages where the full identifier is too sensitive. It ignores
+// DefaultStringFormat.
+func (u ValidatedUUID) Redacted() string {
+	b := []byte(u.UUID.String())
+	for i := redactedVisible; i < len(b); i++ {
+		if b[i] != '-' {
+			b[i] = '*'
+		}
+	}
+	return string(b)
+}
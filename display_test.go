@@ -0,0 +1,155 @@
+package uuid
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatedUUID_ColorHex(t *testing.T) {
+	hexColor := regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+	t.Run("deterministic", func(t *testing.T) {
+		u := New()
+		assert.Equal(t, u.ColorHex(), u.ColorHex())
+		assert.Equal(t, u.ColorHex(), MustParse(u.String()).ColorHex())
+	})
+
+	t.Run("valid hex avoiding extremes", func(t *testing.T) {
+		for i := 0; i < 500; i++ {
+			color := New().ColorHex()
+			require.Len(t, color, 7)
+			require.Regexp(t, hexColor, color)
+
+			var sum int64
+			for j := 1; j < 7; j += 2 {
+				v, err := strconv.ParseInt(color[j:j+2], 16, 64)
+				require.NoError(t, err)
+				sum += v
+			}
+			assert.Greater(t, sum, int64(3*20), color)
+			assert.Less(t, sum, int64(3*235), color)
+		}
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		seen := make(map[string]struct{})
+		for i := 0; i < 200; i++ {
+			seen[New().ColorHex()] = struct{}{}
+		}
+		assert.Greater(t, len(seen), 150)
+	})
+}
+
+func TestHSLToRGB(t *testing.T) {
+	r, g, b := hslToRGB(0, 1, 0.5)
+	assert.Equal(t, [3]uint8{255, 0, 0}, [3]uint8{r, g, b})
+
+	r, g, b = hslToRGB(1.0/3, 1, 0.5)
+	assert.Equal(t, [3]uint8{0, 255, 0}, [3]uint8{r, g, b})
+
+	r, g, b = hslToRGB(2.0/3, 1, 0.5)
+	assert.Equal(t, [3]uint8{0, 0, 255}, [3]uint8{r, g, b})
+}
+
+func TestValidatedUUID_DisplayID(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			u := New()
+			id := u.DisplayID()
+			require.Len(t, id, 27)
+
+			parsed, err := ParseDisplayID(id)
+			require.NoError(t, err)
+			assert.Equal(t, u, parsed)
+		}
+	})
+
+	t.Run("case-insensitive with aliases", func(t *testing.T) {
+		u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+		id := u.DisplayID()
+		lowered := strings.ToLower(strings.ReplaceAll(strings.ReplaceAll(id, "0", "O"), "1", "l"))
+
+		parsed, err := ParseDisplayID(lowered)
+		require.NoError(t, err)
+		assert.Equal(t, u, parsed)
+	})
+
+	t.Run("detects every single-character typo", func(t *testing.T) {
+		id := New().DisplayID()
+		for pos := 0; pos < len(id); pos++ {
+			for _, c := range crockfordAlphabet {
+				if byte(c) == id[pos] {
+					continue
+				}
+				typo := id[:pos] + string(c) + id[pos+1:]
+				_, err := ParseDisplayID(typo)
+				assert.Error(t, err, "typo %q at %d not detected", c, pos)
+			}
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		for _, s := range []string{"", "short", strings.Repeat("0", 27), strings.Repeat("U", 27)} {
+			_, err := ParseDisplayID(s)
+			assert.Error(t, err, s)
+		}
+	})
+}
+
+func TestValidatedUUID_EmojiFingerprint(t *testing.T) {
+	assert.Len(t, fingerprintEmoji, 64)
+
+	t.Run("stable with correct length", func(t *testing.T) {
+		u := New()
+		for _, n := range []int{1, 4, 8, 32, 33, 100} {
+			fp := u.EmojiFingerprint(n)
+			assert.Equal(t, n, utf8.RuneCountInString(fp))
+			assert.Equal(t, fp, u.EmojiFingerprint(n))
+		}
+	})
+
+	t.Run("shorter fingerprints are prefixes", func(t *testing.T) {
+		u := New()
+		assert.True(t, strings.HasPrefix(u.EmojiFingerprint(40), u.EmojiFingerprint(6)))
+	})
+
+	t.Run("differs between UUIDs", func(t *testing.T) {
+		assert.NotEqual(t, New().EmojiFingerprint(8), New().EmojiFingerprint(8))
+	})
+
+	t.Run("non-positive n", func(t *testing.T) {
+		assert.Empty(t, New().EmojiFingerprint(0))
+		assert.Empty(t, New().EmojiFingerprint(-1))
+	})
+}
+
+func TestValidatedUUID_Short(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+	assert.Equal(t, "550e8400", u.Short())
+	assert.Equal(t, "00000000", ValidatedUUID{}.Short())
+}
+
+func TestValidatedUUID_Redacted(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+	assert.Equal(t, "550e84**-****-****-****-************", u.Redacted())
+	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", u.String())
+}
+
+func TestValidatedUUID_ShortRedacted_IgnoreDefaultStringFormat(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	original := DefaultStringFormat
+	t.Cleanup(func() { DefaultStringFormat = original })
+
+	for _, style := range []FormatStyle{FormatUppercase, FormatBraced, FormatURN, FormatSimple, FormatBase62} {
+		DefaultStringFormat = style
+		assert.Equal(t, "550e8400", u.Short(), style.String())
+		assert.Equal(t, "550e84**-****-****-****-************", u.Redacted(), style.String())
+	}
+}
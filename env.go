@@ -0,0 +1,33 @@
+package uuid
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// FromEnv reads the environment variable key, trims surrounding whitespace and
+// parses it as a ValidatedUUID. The error names the variable when it is unset,
+// empty or malformed.
+func FromEnv(key string) (ValidatedUUID, error) {
+	raw, ok := os.LookupEnv(key)
+	if !ok {
+		return ValidatedUUID{}, fmt.Errorf("environment variable %s is not set", key)
+	}
+
+	u, err := Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return ValidatedUUID{}, fmt.Errorf("environment variable %s: %w", key, err)
+	}
+	return u, nil
+}
+
+// FromEnvOr returns the UUID stored in the environment variable key, or
+// fallback when it is unset or invalid
+func FromEnvOr(key string, fallback ValidatedUUID) ValidatedUUID {
+	u, err := FromEnv(key)
+	if err != nil {
+		return fallback
+	}
+	return u
+}
@@ -0,0 +1,62 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromEnv(t *testing.T) {
+	const key = "ALEXHELD_UUID_TEST_ID"
+	validUUIDStr := "550e8400-e29b-41d4-a716-446655440000"
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv(key, "  "+validUUIDStr+"\n")
+		u, err := FromEnv(key)
+		require.NoError(t, err)
+		assert.Equal(t, validUUIDStr, u.String())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		_, err := FromEnv(key + "_MISSING")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), key+"_MISSING")
+		assert.Contains(t, err.Error(), "not set")
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		t.Setenv(key, "not-a-uuid")
+		_, err := FromEnv(key)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), key)
+		assert.Contains(t, err.Error(), "invalid UUID format")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Setenv(key, "   ")
+		_, err := FromEnv(key)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be empty")
+	})
+}
+
+func TestFromEnvOr(t *testing.T) {
+	const key = "ALEXHELD_UUID_TEST_ID"
+	fallback := New()
+
+	t.Run("set", func(t *testing.T) {
+		u := New()
+		t.Setenv(key, u.String())
+		assert.Equal(t, u, FromEnvOr(key, fallback))
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		assert.Equal(t, fallback, FromEnvOr(key+"_MISSING", fallback))
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		t.Setenv(key, "not-a-uuid")
+		assert.Equal(t, fallback, FromEnvOr(key, fallback))
+	})
+}
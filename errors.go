@@ -0,0 +1,66 @@
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Sentinel errors for branching with errors.Is. Errors returned by this
+// package wrap one of these where they apply, keeping their detail text.
+var (
+	// ErrEmpty reports an empty input string
+	ErrEmpty = errors.New("UUID cannot be empty")
+	// ErrNil reports the nil (all-zero) UUID or a missing value
+	ErrNil = errors.New("UUID cannot be nil/zero value")
+	// ErrInvalidFormat reports input that is not a recognized UUID encoding
+	ErrInvalidFormat = errors.New("invalid UUID format")
+	// ErrNilProto reports a nil protobuf UUID message
+	ErrNilProto = errors.New("protobuf UUID cannot be nil")
+	// ErrNotTimeBased reports a UUID version without an embedded timestamp
+	ErrNotTimeBased = errors.New("UUID does not embed a timestamp")
+)
+
+// ValidationError reports a UUID that failed validation during an operation
+// such as JSON unmarshalling. Use errors.As to retrieve it and errors.Is on it
+// to test the underlying cause.
+type ValidationError struct {
+	// Op is the operation in progress, such as "database scan"
+	Op string
+	// Field names the offending field, when known
+	Field string
+	// Err is the underlying cause
+	Err error
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	msg := "UUID validation failed"
+	if e.Field != "" {
+		msg += " for field " + strconv.Quote(e.Field)
+	}
+	if e.Op != "" {
+		msg += " during " + e.Op
+	}
+	return msg + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying cause
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// validationFailed wraps err in a ValidationError for op
+func validationFailed(op string, err error) error {
+	return &ValidationError{Op: op, Err: err}
+}
+
+// invalidFormatf returns an error wrapping ErrInvalidFormat with detail
+func invalidFormatf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidFormat, fmt.Sprintf(format, args...))
+}
+
+// invalidFormat wraps err under ErrInvalidFormat
+func invalidFormat(err error) error {
+	return fmt.Errorf("%w: %w", ErrInvalidFormat, err)
+}
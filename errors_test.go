@@ -0,0 +1,69 @@
+package uuid
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "empty", err: func() error { _, err := Parse(""); return err }(), want: ErrEmpty},
+		{name: "nil", err: func() error { _, err := Parse("00000000-0000-0000-0000-000000000000"); return err }(), want: ErrNil},
+		{name: "format", err: func() error { _, err := Parse("invalid"); return err }(), want: ErrInvalidFormat},
+		{name: "strict format", err: func() error { _, err := ParseStrict("550E8400-E29B-41D4-A716-446655440000"); return err }(), want: ErrInvalidFormat},
+		{name: "flexible format", err: func() error { _, err := ParseFlexible("!!"); return err }(), want: ErrInvalidFormat},
+		{name: "zero value", err: ValidatedUUID{}.Validate(), want: ErrNil},
+		{name: "nil proto", err: func() error { _, err := FromProto(nil); return err }(), want: ErrNilProto},
+		{name: "scan nil", err: new(ValidatedUUID).Scan(nil), want: ErrNil},
+		{name: "binary length", err: new(ValidatedUUID).UnmarshalBinary([]byte{1}), want: ErrInvalidFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Error(t, tt.err)
+			assert.ErrorIs(t, tt.err, tt.want)
+		})
+	}
+
+	t.Run("messages keep detail", func(t *testing.T) {
+		_, err := Parse("invalid")
+		assert.True(t, strings.HasPrefix(err.Error(), "invalid UUID format: "))
+		assert.Equal(t, "UUID cannot be nil/zero value", ValidatedUUID{}.Validate().Error())
+	})
+}
+
+func TestValidationError(t *testing.T) {
+	t.Run("wraps marshalling failures", func(t *testing.T) {
+		var u ValidatedUUID
+		err := json.Unmarshal([]byte(`"invalid"`), &u)
+
+		var verr *ValidationError
+		require.True(t, errors.As(err, &verr))
+		assert.Equal(t, "JSON unmarshalling", verr.Op)
+		assert.ErrorIs(t, err, ErrInvalidFormat)
+		assert.True(t, strings.HasPrefix(err.Error(), "UUID validation failed during JSON unmarshalling: invalid UUID format"))
+	})
+
+	t.Run("names the offending field", func(t *testing.T) {
+		_, err := DecodeSingleIDBody(strings.NewReader(`{"user_id":"00000000-0000-0000-0000-000000000000"}`), "user_id")
+
+		var verr *ValidationError
+		require.True(t, errors.As(err, &verr))
+		assert.Equal(t, "user_id", verr.Field)
+		assert.ErrorIs(t, err, ErrNil)
+		assert.Equal(t, `UUID validation failed for field "user_id" during JSON unmarshalling: UUID cannot be nil/zero value`, err.Error())
+	})
+
+	t.Run("without op", func(t *testing.T) {
+		_, err := ValidatedUUID{}.ToStringValue()
+		assert.Equal(t, "UUID validation failed: UUID cannot be nil/zero value", err.Error())
+	})
+}
@@ -0,0 +1,42 @@
+package uuid
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// canonicalPattern matches canonical hyphenated UUIDs in either case
+var canonicalPattern = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+
+// ExtractFromURL returns the first path segment of rawurl that is a valid,
+// non-nil UUID, e.g. the user ID in /v1/users/<uuid>/profile
+func ExtractFromURL(rawurl string) (ValidatedUUID, error) {
+	parsed, err := url.Parse(rawurl)
+	if err != nil {
+		return ValidatedUUID{}, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	for _, segment := range strings.Split(parsed.Path, "/") {
+		if segment == "" {
+			continue
+		}
+		if u, err := Parse(segment); err == nil {
+			return u, nil
+		}
+	}
+	return ValidatedUUID{}, fmt.Errorf("no UUID found in URL path %q", parsed.Path)
+}
+
+// ExtractAllFromText returns every valid, non-nil canonical UUID found in s,
+// in order of appearance
+func ExtractAllFromText(s string) []ValidatedUUID {
+	var ids []ValidatedUUID
+	for _, match := range canonicalPattern.FindAllString(s, -1) {
+		if u, err := Parse(match); err == nil {
+			ids = append(ids, u)
+		}
+	}
+	return ids
+}
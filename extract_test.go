@@ -0,0 +1,60 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractFromURL(t *testing.T) {
+	a := MustParse("550e8400-e29b-41d4-a716-446655440000")
+	b := MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+
+	t.Run("one UUID", func(t *testing.T) {
+		u, err := ExtractFromURL("https://api.example.com/v1/users/" + a.String() + "/profile?x=1")
+		require.NoError(t, err)
+		assert.Equal(t, a, u)
+	})
+
+	t.Run("multiple UUIDs returns the first", func(t *testing.T) {
+		u, err := ExtractFromURL("/v1/orgs/" + b.String() + "/users/" + a.String())
+		require.NoError(t, err)
+		assert.Equal(t, b, u)
+	})
+
+	t.Run("skips nil UUID", func(t *testing.T) {
+		u, err := ExtractFromURL("/v1/orgs/00000000-0000-0000-0000-000000000000/users/" + a.String())
+		require.NoError(t, err)
+		assert.Equal(t, a, u)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		_, err := ExtractFromURL("https://api.example.com/v1/users/me?id=" + a.String())
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "no UUID found")
+	})
+
+	t.Run("invalid URL", func(t *testing.T) {
+		_, err := ExtractFromURL("http://[::1")
+		assert.Error(t, err)
+	})
+}
+
+func TestExtractAllFromText(t *testing.T) {
+	a := MustParse("550e8400-e29b-41d4-a716-446655440000")
+	b := MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+
+	t.Run("multiple UUIDs", func(t *testing.T) {
+		text := "user " + a.String() + " placed order (01890A5D-AC96-774B-BCCE-B302099A8057), nil 00000000-0000-0000-0000-000000000000"
+		assert.Equal(t, []ValidatedUUID{a, b}, ExtractAllFromText(text))
+	})
+
+	t.Run("ignores embedded hex runs", func(t *testing.T) {
+		assert.Empty(t, ExtractAllFromText("x"+a.String()+"ff"))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		assert.Empty(t, ExtractAllFromText("nothing to see here"))
+	})
+}
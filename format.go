@@ -0,0 +1,295 @@
+package uuid
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// FormatStyle selects a textual representation of a UUID
+type FormatStyle int
+
+const (
+	// FormatCanonical is the lowercase hyphenated form, e.g. 550e8400-e29b-41d4-a716-446655440000
+	FormatCanonical FormatStyle = iota
+	// FormatSimple is the lowercase form without hyphens, e.g. 550e8400e29b41d4a716446655440000
+	FormatSimple
+	// FormatUppercase is the uppercase hyphenated form, e.g. 550E8400-E29B-41D4-A716-446655440000
+	FormatUppercase
+	// FormatBraced is the canonical form wrapped in braces, e.g. {550e8400-e29b-41d4-a716-446655440000}
+	FormatBraced
+	// FormatURN is the RFC 4122 URN form, e.g. urn:uuid:550e8400-e29b-41d4-a716-446655440000
+	FormatURN
+	// FormatBase62 is the 128-bit value in base62, zero-padded to 22 characters
+	// and prefixed with b62_
+	FormatBase62
+	// FormatBase58 is the 128-bit value in the Bitcoin base58 alphabet,
+	// zero-padded to 22 characters and prefixed with b58_
+	FormatBase58
+	// FormatBase64URL is the 16 raw bytes in unpadded URL-safe base64
+	// (22 characters), prefixed with b64_
+	FormatBase64URL
+)
+
+const (
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	// shortFormLen is the length of the base62, base58 and base64url forms
+	// without their prefix
+	shortFormLen = 22
+)
+
+// shortFormPrefixes tell the short forms apart: their alphabets overlap, so
+// most unprefixed strings decode as more than one of them
+var shortFormPrefixes = map[FormatStyle]string{
+	FormatBase62:    "b62_",
+	FormatBase58:    "b58_",
+	FormatBase64URL: "b64_",
+}
+
+// DefaultStringFormat is the style used by String and therefore by JSON and
+// protobuf marshalling. It is global and affects every ValidatedUUID in the
+// process, so it should only be set once during initialization. Only styles
+// that Parse accepts apply (canonical, simple, uppercase, braced and URN);
+// String ignores the short styles and uses the canonical form. Database
+// values are always written in canonical form.
+var DefaultStringFormat = FormatCanonical
+
+// String returns the name of the format style
+func (f FormatStyle) String() string {
+	switch f {
+	case FormatCanonical:
+		return "canonical"
+	case FormatSimple:
+		return "simple"
+	case FormatUppercase:
+		return "uppercase"
+	case FormatBraced:
+		return "braced"
+	case FormatURN:
+		return "urn"
+	case FormatBase62:
+		return "base62"
+	case FormatBase58:
+		return "base58"
+	case FormatBase64URL:
+		return "base64url"
+	default:
+		return fmt.Sprintf("FormatStyle(%d)", int(f))
+	}
+}
+
+// isParseable reports whether Parse accepts UUIDs rendered in style
+func (f FormatStyle) isParseable() bool {
+	switch f {
+	case FormatCanonical, FormatSimple, FormatUppercase, FormatBraced, FormatURN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Formatted returns the UUID rendered in the given style, falling back to
+// the canonical form for unknown styles
+func (u ValidatedUUID) Formatted(style FormatStyle) string {
+	canonical := u.UUID.String()
+
+	switch style {
+	case FormatSimple:
+		return strings.ReplaceAll(canonical, "-", "")
+	case FormatUppercase:
+		return strings.ToUpper(canonical)
+	case FormatBraced:
+		return "{" + canonical + "}"
+	case FormatURN:
+		return u.UUID.URN()
+	case FormatBase62:
+		return shortFormPrefixes[style] + encodeBaseN(u.UUID, base62Alphabet)
+	case FormatBase58:
+		return shortFormPrefixes[style] + encodeBaseN(u.UUID, base58Alphabet)
+	case FormatBase64URL:
+		return shortFormPrefixes[style] + base64.RawURLEncoding.EncodeToString(u.UUID[:])
+	default:
+		return canonical
+	}
+}
+
+// DetectFormat reports which FormatStyle s is written in and whether it holds
+// a valid, non-nil UUID in that form. Short forms are recognized by their
+// prefix. An unprefixed 22-character string is reported only when exactly one
+// short style decodes it; otherwise ok is false and the caller must name the
+// style with ParseStyle.
+func DetectFormat(s string) (FormatStyle, bool) {
+	var (
+		found   FormatStyle
+		matches int
+	)
+	for _, style := range candidateStyles(s) {
+		if u, err := decodeStyle(s, style); err == nil && u != uuid.Nil {
+			found = style
+			matches++
+		}
+	}
+	if matches != 1 {
+		return FormatCanonical, false
+	}
+	return found, true
+}
+
+// ParseFlexible parses s in any FormatStyle recognized by DetectFormat
+func ParseFlexible(s string) (ValidatedUUID, error) {
+	if s == "" {
+		return ValidatedUUID{}, ErrEmpty
+	}
+
+	style, ok := DetectFormat(s)
+	if !ok {
+		return ValidatedUUID{}, invalidFormatf("unrecognized or ambiguous encoding")
+	}
+	return ParseStyle(s, style)
+}
+
+// ParseStyle parses s written in style. Short forms are accepted with or
+// without their prefix.
+func ParseStyle(s string, style FormatStyle) (ValidatedUUID, error) {
+	if s == "" {
+		return ValidatedUUID{}, ErrEmpty
+	}
+
+	u, err := decodeStyle(s, style)
+	if err != nil {
+		return ValidatedUUID{}, invalidFormat(err)
+	}
+	return FromGoogleUUID(u)
+}
+
+// candidateStyles returns the styles that could match s based on its shape
+func candidateStyles(s string) []FormatStyle {
+	switch {
+	case len(s) == 36 && s == strings.ToLower(s):
+		return []FormatStyle{FormatCanonical}
+	case len(s) == 36:
+		return []FormatStyle{FormatUppercase}
+	case len(s) == 32:
+		return []FormatStyle{FormatSimple}
+	case len(s) == 38 && s[0] == '{' && s[37] == '}':
+		return []FormatStyle{FormatBraced}
+	case len(s) == 45 && strings.EqualFold(s[:9], "urn:uuid:"):
+		return []FormatStyle{FormatURN}
+	case len(s) == shortFormLen:
+		return []FormatStyle{FormatBase62, FormatBase58, FormatBase64URL}
+	}
+	for _, style := range []FormatStyle{FormatBase62, FormatBase58, FormatBase64URL} {
+		if strings.HasPrefix(s, shortFormPrefixes[style]) {
+			return []FormatStyle{style}
+		}
+	}
+	return nil
+}
+
+// decodeStyle decodes s assuming it is written in style
+func decodeStyle(s string, style FormatStyle) (uuid.UUID, error) {
+	if prefix, ok := shortFormPrefixes[style]; ok {
+		s = strings.TrimPrefix(s, prefix)
+	}
+
+	switch style {
+	case FormatBase62:
+		return decodeBaseN(s, base62Alphabet)
+	case FormatBase58:
+		return decodeBaseN(s, base58Alphabet)
+	case FormatBase64URL:
+		if len(s) != shortFormLen {
+			return uuid.Nil, fmt.Errorf("invalid base64url length %d", len(s))
+		}
+		b, err := base64.RawURLEncoding.Strict().DecodeString(s)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return uuid.FromBytes(b)
+	default:
+		return uuid.Parse(s)
+	}
+}
+
+// encodeBaseN renders u as a big-endian number in the given alphabet,
+// left-padded with the zero digit to shortFormLen characters
+func encodeBaseN(u uuid.UUID, alphabet string) string {
+	n := new(big.Int).SetBytes(u[:])
+	base := big.NewInt(int64(len(alphabet)))
+	rem := new(big.Int)
+
+	out := make([]byte, shortFormLen)
+	for i := shortFormLen - 1; i >= 0; i-- {
+		n.DivMod(n, base, rem)
+		out[i] = alphabet[rem.Int64()]
+	}
+	return string(out)
+}
+
+// decodeBaseN parses a shortFormLen number written in the given alphabet
+func decodeBaseN(s, alphabet string) (uuid.UUID, error) {
+	if len(s) != shortFormLen {
+		return uuid.Nil, fmt.Errorf("invalid encoded length %d", len(s))
+	}
+
+	n := new(big.Int)
+	base := big.NewInt(int64(len(alphabet)))
+	for i := 0; i < len(s); i++ {
+		digit := strings.IndexByte(alphabet, s[i])
+		if digit < 0 {
+			return uuid.Nil, fmt.Errorf("invalid character %q at position %d", s[i], i)
+		}
+		n.Mul(n, base)
+		n.Add(n, big.NewInt(int64(digit)))
+	}
+	if n.BitLen() > 128 {
+		return uuid.Nil, fmt.Errorf("encoded value exceeds 128 bits")
+	}
+
+	var u uuid.UUID
+	n.FillBytes(u[:])
+	return u, nil
+}
+
+// Format implements fmt.Formatter. %s and %v print the canonical form, %q
+// quotes it, %x and %X print the compact hex form in lower and upper case, and
+// %+v appends the version and variant. Width and precision apply as for
+// strings. %#v prints a Go expression reconstructing the value. Unlike String,
+// every verb ignores DefaultStringFormat.
+func (u ValidatedUUID) Format(f fmt.State, verb rune) {
+	var s string
+	switch verb {
+	case 's', 'q':
+		s = u.UUID.String()
+	case 'v':
+		switch {
+		case f.Flag('#') && u.IsZero():
+			fmt.Fprint(f, "uuid.ValidatedUUID{}")
+			return
+		case f.Flag('#'):
+			fmt.Fprintf(f, "uuid.MustParse(%q)", u.UUID.String())
+			return
+		case f.Flag('+'):
+			s = fmt.Sprintf("%s (version %d, %s)", u.UUID.String(), u.Version(), u.UUID.Variant())
+		default:
+			s = u.UUID.String()
+		}
+	case 'x':
+		s = u.Formatted(FormatSimple)
+	case 'X':
+		s = strings.ToUpper(u.Formatted(FormatSimple))
+	default:
+		fmt.Fprintf(f, "%%!%c(uuid.ValidatedUUID=%s)", verb, u.UUID.String())
+		return
+	}
+
+	if verb != 'q' {
+		verb = 's'
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), s)
+}
@@ -0,0 +1,257 @@
+package uuid
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatedUUID_Formatted(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	tests := []struct {
+		style FormatStyle
+		want  string
+	}{
+		{style: FormatCanonical, want: "550e8400-e29b-41d4-a716-446655440000"},
+		{style: FormatSimple, want: "550e8400e29b41d4a716446655440000"},
+		{style: FormatUppercase, want: "550E8400-E29B-41D4-A716-446655440000"},
+		{style: FormatBraced, want: "{550e8400-e29b-41d4-a716-446655440000}"},
+		{style: FormatURN, want: "urn:uuid:550e8400-e29b-41d4-a716-446655440000"},
+		{style: FormatBase62, want: "b62_2aUyqjCzEIiEcYMKj7TZtw"},
+		{style: FormatBase58, want: "b58_BWBeN28Vb7cMEx7Ym8AUzs"},
+		{style: FormatBase64URL, want: "b64_VQ6EAOKbQdSnFkRmVUQAAA"},
+		{style: FormatStyle(99), want: "550e8400-e29b-41d4-a716-446655440000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.style.String(), func(t *testing.T) {
+			formatted := u.Formatted(tt.style)
+			assert.Equal(t, tt.want, formatted)
+
+			parsed, err := ParseFlexible(formatted)
+			require.NoError(t, err)
+			assert.Equal(t, u, parsed)
+		})
+	}
+}
+
+func TestDefaultStringFormat(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	original := DefaultStringFormat
+	t.Cleanup(func() { DefaultStringFormat = original })
+
+	t.Run("uppercase", func(t *testing.T) {
+		DefaultStringFormat = FormatUppercase
+		assert.Equal(t, "550E8400-E29B-41D4-A716-446655440000", u.String())
+
+		data, err := json.Marshal(u)
+		require.NoError(t, err)
+		assert.Equal(t, `"550E8400-E29B-41D4-A716-446655440000"`, string(data))
+
+		var decoded ValidatedUUID
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, u, decoded)
+	})
+
+	t.Run("braced", func(t *testing.T) {
+		DefaultStringFormat = FormatBraced
+		assert.Equal(t, "{550e8400-e29b-41d4-a716-446655440000}", u.String())
+
+		pb, err := u.ToProto()
+		require.NoError(t, err)
+		assert.Equal(t, "{550e8400-e29b-41d4-a716-446655440000}", pb.GetVal())
+	})
+
+	t.Run("parseable styles round trip", func(t *testing.T) {
+		for _, style := range []FormatStyle{FormatCanonical, FormatSimple, FormatUppercase, FormatBraced, FormatURN} {
+			DefaultStringFormat = style
+			assert.Equal(t, u.Formatted(style), u.String(), style.String())
+
+			data, err := json.Marshal(u)
+			require.NoError(t, err, style.String())
+			var fromJSON ValidatedUUID
+			require.NoError(t, json.Unmarshal(data, &fromJSON), style.String())
+			assert.Equal(t, u, fromJSON, style.String())
+
+			text, err := u.MarshalText()
+			require.NoError(t, err, style.String())
+			var fromText ValidatedUUID
+			require.NoError(t, fromText.UnmarshalText(text), style.String())
+			assert.Equal(t, u, fromText, style.String())
+
+			pb, err := u.ToProto()
+			require.NoError(t, err, style.String())
+			fromProto, err := FromProto(pb)
+			require.NoError(t, err, style.String())
+			assert.Equal(t, u, fromProto, style.String())
+		}
+	})
+
+	t.Run("short styles are ignored", func(t *testing.T) {
+		for _, style := range []FormatStyle{FormatBase62, FormatBase58, FormatBase64URL} {
+			DefaultStringFormat = style
+			assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", u.String(), style.String())
+
+			id := New()
+			pb, err := id.ToProto()
+			require.NoError(t, err, style.String())
+			fromProto, err := FromProto(pb)
+			require.NoError(t, err, style.String())
+			assert.Equal(t, id, fromProto, style.String())
+		}
+	})
+
+	t.Run("database values stay canonical", func(t *testing.T) {
+		DefaultStringFormat = FormatURN
+		value, err := u.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", value)
+	})
+}
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantStyle FormatStyle
+		wantOK    bool
+	}{
+		{name: "canonical", input: "550e8400-e29b-41d4-a716-446655440000", wantStyle: FormatCanonical, wantOK: true},
+		{name: "simple", input: "550e8400e29b41d4a716446655440000", wantStyle: FormatSimple, wantOK: true},
+		{name: "uppercase", input: "550E8400-E29B-41D4-A716-446655440000", wantStyle: FormatUppercase, wantOK: true},
+		{name: "braced", input: "{550e8400-e29b-41d4-a716-446655440000}", wantStyle: FormatBraced, wantOK: true},
+		{name: "urn", input: "urn:uuid:550e8400-e29b-41d4-a716-446655440000", wantStyle: FormatURN, wantOK: true},
+		{name: "base62", input: "b62_2aUyqjCzEIiEcYMKj7TZtw", wantStyle: FormatBase62, wantOK: true},
+		{name: "base58", input: "b58_BWBeN28Vb7cMEx7Ym8AUzs", wantStyle: FormatBase58, wantOK: true},
+		{name: "base64url", input: "b64_VQ6EAOKbQdSnFkRmVUQAAA", wantStyle: FormatBase64URL, wantOK: true},
+		{name: "ambiguous unprefixed short form", input: "2aUyqjCzEIiEcYMKj7TZtw", wantOK: false},
+		{name: "wrong prefix", input: "b58_VQ6EAOKbQdSnFkRmVUQAAA", wantOK: false},
+		{name: "nil canonical", input: "00000000-0000-0000-0000-000000000000", wantOK: false},
+		{name: "bad hex", input: "zzzzzzzz-e29b-41d4-a716-446655440000", wantOK: false},
+		{name: "unrecognized", input: "not-a-uuid", wantOK: false},
+		{name: "empty", input: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			style, ok := DetectFormat(tt.input)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.wantStyle, style)
+			}
+		})
+	}
+}
+
+func TestParseFlexible(t *testing.T) {
+	t.Run("round trips every style", func(t *testing.T) {
+		for i := 0; i < 500; i++ {
+			u := New()
+			for _, style := range []FormatStyle{
+				FormatCanonical, FormatSimple, FormatUppercase, FormatBraced,
+				FormatURN, FormatBase62, FormatBase58, FormatBase64URL,
+			} {
+				parsed, err := ParseFlexible(u.Formatted(style))
+				require.NoError(t, err, style.String())
+				require.Equal(t, u, parsed, style.String())
+			}
+		}
+	})
+
+	t.Run("ambiguous short form fails", func(t *testing.T) {
+		_, err := ParseFlexible("2aUyqjCzEIiEcYMKj7TZtw")
+		assert.ErrorIs(t, err, ErrInvalidFormat)
+	})
+
+	t.Run("empty fails", func(t *testing.T) {
+		_, err := ParseFlexible("")
+		assert.Error(t, err)
+	})
+
+	t.Run("unrecognized fails", func(t *testing.T) {
+		_, err := ParseFlexible("not-a-uuid")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid UUID format")
+	})
+}
+
+func TestParseStyle(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	for _, style := range []FormatStyle{FormatBase62, FormatBase58, FormatBase64URL} {
+		t.Run(style.String(), func(t *testing.T) {
+			formatted := u.Formatted(style)
+
+			parsed, err := ParseStyle(formatted, style)
+			require.NoError(t, err)
+			assert.Equal(t, u, parsed)
+
+			parsed, err = ParseStyle(formatted[len(shortFormPrefixes[style]):], style)
+			require.NoError(t, err, "unprefixed")
+			assert.Equal(t, u, parsed)
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := ParseStyle("", FormatBase62)
+		assert.ErrorIs(t, err, ErrEmpty)
+
+		_, err = ParseStyle("b58_0000000000000000000000", FormatBase58)
+		assert.ErrorIs(t, err, ErrInvalidFormat)
+
+		_, err = ParseStyle("b62_0000000000000000000000", FormatBase62)
+		assert.ErrorIs(t, err, ErrNil)
+	})
+}
+
+func TestValidatedUUID_Format(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%s", "550e8400-e29b-41d4-a716-446655440000"},
+		{"%v", "550e8400-e29b-41d4-a716-446655440000"},
+		{"%q", `"550e8400-e29b-41d4-a716-446655440000"`},
+		{"%x", "550e8400e29b41d4a716446655440000"},
+		{"%X", "550E8400E29B41D4A716446655440000"},
+		{"%+v", "550e8400-e29b-41d4-a716-446655440000 (version 4, RFC4122)"},
+		{"%#v", `uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")`},
+		{"%.8s", "550e8400"},
+		{"%-40s|", "550e8400-e29b-41d4-a716-446655440000    |"},
+		{"%d", "%!d(uuid.ValidatedUUID=550e8400-e29b-41d4-a716-446655440000)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			assert.Equal(t, tt.want, fmt.Sprintf(tt.format, u))
+		})
+	}
+
+	t.Run("zero value", func(t *testing.T) {
+		assert.Equal(t, "uuid.ValidatedUUID{}", fmt.Sprintf("%#v", ValidatedUUID{}))
+		assert.Equal(t, "00000000-0000-0000-0000-000000000000", fmt.Sprintf("%v", ValidatedUUID{}))
+	})
+
+	t.Run("ignores DefaultStringFormat", func(t *testing.T) {
+		original := DefaultStringFormat
+		t.Cleanup(func() { DefaultStringFormat = original })
+		DefaultStringFormat = FormatURN
+
+		assert.Equal(t, "urn:uuid:550e8400-e29b-41d4-a716-446655440000", u.String())
+		for _, tt := range tests {
+			assert.Equal(t, tt.want, fmt.Sprintf(tt.format, u), tt.format)
+		}
+	})
+
+	t.Run("pointer and nested", func(t *testing.T) {
+		assert.Equal(t, "550e8400e29b41d4a716446655440000", fmt.Sprintf("%x", &u))
+		assert.Equal(t, "[550e8400-e29b-41d4-a716-446655440000]", fmt.Sprintf("%v", []ValidatedUUID{u}))
+	})
+}
@@ -0,0 +1,63 @@
+package uuid
+
+import (
+	"cmp"
+	"encoding/binary"
+	"slices"
+)
+
+// FrozenSet is an immutable set of UUIDs stored as one sorted, contiguous
+// slice of 16-byte values. Membership checks use binary search; for large
+// read-only allow-lists this trades some lookup speed for a much smaller,
+// pointer-free memory footprint than a map.
+type FrozenSet struct {
+	values []frozenValue
+}
+
+// frozenValue is a UUID split into big-endian halves for fast comparison
+type frozenValue struct {
+	hi, lo uint64
+}
+
+func toFrozenValue(u ValidatedUUID) frozenValue {
+	return frozenValue{
+		hi: binary.BigEndian.Uint64(u.UUID[:8]),
+		lo: binary.BigEndian.Uint64(u.UUID[8:]),
+	}
+}
+
+// NewFrozenSet builds a FrozenSet from ids, dropping duplicates
+func NewFrozenSet(ids []ValidatedUUID) FrozenSet {
+	values := make([]frozenValue, len(ids))
+	for i, u := range ids {
+		values[i] = toFrozenValue(u)
+	}
+	slices.SortFunc(values, func(a, b frozenValue) int {
+		if c := cmp.Compare(a.hi, b.hi); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.lo, b.lo)
+	})
+	return FrozenSet{values: slices.Compact(values)}
+}
+
+// Contains reports whether u is in the set
+func (s FrozenSet) Contains(u ValidatedUUID) bool {
+	v := toFrozenValue(u)
+	lo, hi := 0, len(s.values)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		m := s.values[mid]
+		if m.hi < v.hi || (m.hi == v.hi && m.lo < v.lo) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo < len(s.values) && s.values[lo] == v
+}
+
+// Len returns the number of distinct UUIDs in the set
+func (s FrozenSet) Len() int {
+	return len(s.values)
+}
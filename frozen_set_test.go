@@ -0,0 +1,70 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrozenSet(t *testing.T) {
+	ids := make([]ValidatedUUID, 100)
+	for i := range ids {
+		ids[i] = New()
+	}
+
+	t.Run("contains members", func(t *testing.T) {
+		s := NewFrozenSet(ids)
+		assert.Equal(t, len(ids), s.Len())
+		for _, u := range ids {
+			assert.True(t, s.Contains(u))
+		}
+	})
+
+	t.Run("rejects non-members", func(t *testing.T) {
+		s := NewFrozenSet(ids)
+		for i := 0; i < 100; i++ {
+			assert.False(t, s.Contains(New()))
+		}
+		assert.False(t, s.Contains(ValidatedUUID{}))
+	})
+
+	t.Run("drops duplicates", func(t *testing.T) {
+		s := NewFrozenSet(append(append([]ValidatedUUID{}, ids...), ids...))
+		assert.Equal(t, len(ids), s.Len())
+	})
+
+	t.Run("empty set", func(t *testing.T) {
+		var s FrozenSet
+		assert.Zero(t, s.Len())
+		assert.False(t, s.Contains(New()))
+		assert.False(t, NewFrozenSet(nil).Contains(New()))
+	})
+}
+
+func benchmarkIDs(n int) []ValidatedUUID {
+	ids := make([]ValidatedUUID, n)
+	for i := range ids {
+		ids[i] = New()
+	}
+	return ids
+}
+
+func BenchmarkFrozenSet_Contains(b *testing.B) {
+	ids := benchmarkIDs(100000)
+	s := NewFrozenSet(ids)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Contains(ids[i%len(ids)])
+	}
+}
+
+func BenchmarkSet_Contains(b *testing.B) {
+	ids := benchmarkIDs(100000)
+	s := NewSet(ids...)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Contains(ids[i%len(ids)])
+	}
+}
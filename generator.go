@@ -0,0 +1,136 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Generator produces v4 UUIDs from a configurable entropy source
+type Generator struct {
+	rand   io.Reader
+	tagged bool
+	tag    byte
+}
+
+// GeneratorOption configures a Generator
+type GeneratorOption func(*Generator)
+
+// WithRandReader sets the entropy source, defaulting to crypto/rand
+func WithRandReader(r io.Reader) GeneratorOption {
+	return func(g *Generator) {
+		g.rand = r
+	}
+}
+
+// WithSessionTag makes the Generator stamp a random per-instance session byte
+// into the last byte of every UUID it produces, so Produced can attribute
+// UUIDs to it. This reserves 8 of the 122 random bits, leaving 114 bits of
+// entropy, and a foreign v4 UUID matches the tag with probability 1/256.
+func WithSessionTag() GeneratorOption {
+	return func(g *Generator) {
+		g.tagged = true
+	}
+}
+
+// NewGenerator creates a Generator configured by opts
+func NewGenerator(opts ...GeneratorOption) (*Generator, error) {
+	g := &Generator{rand: rand.Reader}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	if g.tagged {
+		var tag [1]byte
+		if _, err := io.ReadFull(g.rand, tag[:]); err != nil {
+			return nil, fmt.Errorf("failed to read session tag: %w", err)
+		}
+		g.tag = tag[0]
+	}
+	return g, nil
+}
+
+// New generates a v4 UUID
+func (g *Generator) New() (ValidatedUUID, error) {
+	u, err := uuid.NewRandomFromReader(g.rand)
+	if err != nil {
+		return ValidatedUUID{}, fmt.Errorf("failed to generate UUID: %w", err)
+	}
+	if g.tagged {
+		u[15] = g.tag
+	}
+	return FromGoogleUUID(u)
+}
+
+// Produced reports whether u carries this Generator's session tag. It always
+// returns false for Generators created without WithSessionTag.
+func (g *Generator) Produced(u ValidatedUUID) bool {
+	return g.tagged && u.IsV4() && u.UUID[15] == g.tag
+}
+
+// NewBatch generates n v4 UUIDs, drawing their entropy from crypto/rand in a
+// single read instead of one per UUID. Like New, it panics if the random
+// source fails. It returns nil when n <= 0.
+func NewBatch(n int) []ValidatedUUID {
+	if n <= 0 {
+		return nil
+	}
+
+	buf := make([]byte, n*16)
+	if _, err := io.ReadFull(rand.Reader, buf); err != nil {
+		panic(fmt.Errorf("failed to read entropy for UUID batch: %w", err))
+	}
+
+	ids := make([]ValidatedUUID, n)
+	for i := range ids {
+		u := &ids[i].UUID
+		copy(u[:], buf[i*16:])
+		u[6] = (u[6] & 0x0f) | 0x40 // version 4
+		u[8] = (u[8] & 0x3f) | 0x80 // RFC 4122 variant
+	}
+	return ids
+}
+
+// BenchmarkGeneration generates UUIDs of the given version (1, 4, 6 or 7) for
+// roughly d and reports how many were produced and the rate per second, as a
+// runtime self-diagnostic
+func BenchmarkGeneration(d time.Duration, version int) (count int, perSec float64, err error) {
+	if d <= 0 {
+		return 0, 0, fmt.Errorf("benchmark duration must be positive, got %s", d)
+	}
+
+	var generate func() (uuid.UUID, error)
+	switch version {
+	case 1:
+		generate = uuid.NewUUID
+	case 4:
+		generate = uuid.NewRandom
+	case 6:
+		generate = newV6
+	case 7:
+		generate = uuid.NewV7
+	default:
+		return 0, 0, fmt.Errorf("unsupported UUID version for generation: %d", version)
+	}
+
+	start := time.Now()
+	deadline := start.Add(d)
+	for {
+		// Check the clock in batches to keep its cost out of the measurement
+		for i := 0; i < 64; i++ {
+			if _, err := generate(); err != nil {
+				return count, 0, fmt.Errorf("failed to generate UUID: %w", err)
+			}
+			count++
+		}
+		if !time.Now().Before(deadline) {
+			break
+		}
+	}
+
+	elapsed := time.Since(start)
+	return count, float64(count) / elapsed.Seconds(), nil
+}
@@ -0,0 +1,111 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator(t *testing.T) {
+	t.Run("generates v4", func(t *testing.T) {
+		g, err := NewGenerator()
+		require.NoError(t, err)
+
+		u, err := g.New()
+		require.NoError(t, err)
+		assert.Equal(t, 4, int(u.UUID.Version()))
+		assert.False(t, g.Produced(u))
+	})
+
+	t.Run("custom reader", func(t *testing.T) {
+		g, err := NewGenerator(WithRandReader(bytes.NewReader(bytes.Repeat([]byte{0xab}, 16))))
+		require.NoError(t, err)
+
+		u, err := g.New()
+		require.NoError(t, err)
+		assert.Equal(t, "abababab-abab-4bab-abab-abababababab", u.String())
+
+		_, err = g.New()
+		assert.Error(t, err)
+	})
+
+	t.Run("session attribution", func(t *testing.T) {
+		reader := bytes.NewReader(append([]byte{0x01}, bytes.Repeat([]byte{0x55}, 32)...))
+		g, err := NewGenerator(WithRandReader(reader), WithSessionTag())
+		require.NoError(t, err)
+		other, err := NewGenerator(WithRandReader(bytes.NewReader([]byte{0x02})), WithSessionTag())
+		require.NoError(t, err)
+
+		u, err := g.New()
+		require.NoError(t, err)
+		assert.True(t, g.Produced(u))
+		assert.False(t, other.Produced(u))
+
+		foreign := MustParse("55555555-5555-4555-9555-555555555500")
+		assert.False(t, g.Produced(foreign))
+	})
+
+	t.Run("session tag read failure", func(t *testing.T) {
+		_, err := NewGenerator(WithRandReader(bytes.NewReader(nil)), WithSessionTag())
+		assert.Error(t, err)
+	})
+}
+
+func TestNewBatch(t *testing.T) {
+	ids := NewBatch(1000)
+	require.Len(t, ids, 1000)
+
+	seen := make(map[ValidatedUUID]bool, len(ids))
+	for _, u := range ids {
+		require.NoError(t, u.Validate())
+		assert.Equal(t, uuid.Version(4), u.UUID.Version())
+		assert.Equal(t, uuid.RFC4122, u.UUID.Variant())
+		seen[u] = true
+	}
+	assert.Len(t, seen, len(ids))
+
+	assert.Nil(t, NewBatch(0))
+	assert.Nil(t, NewBatch(-1))
+}
+
+func BenchmarkNewBatch(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		NewBatch(256)
+	}
+}
+
+func BenchmarkNewLoop(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 256; j++ {
+			New()
+		}
+	}
+}
+
+func TestBenchmarkGeneration(t *testing.T) {
+	for _, version := range []int{1, 4, 6, 7} {
+		start := time.Now()
+		count, perSec, err := BenchmarkGeneration(20*time.Millisecond, version)
+		elapsed := time.Since(start)
+
+		require.NoError(t, err, "version %d", version)
+		assert.Positive(t, count)
+		assert.Positive(t, perSec)
+		assert.GreaterOrEqual(t, elapsed, 20*time.Millisecond)
+		assert.Less(t, elapsed, time.Second)
+	}
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, _, err := BenchmarkGeneration(time.Millisecond, 5)
+		assert.Error(t, err)
+	})
+
+	t.Run("non-positive duration", func(t *testing.T) {
+		_, _, err := BenchmarkGeneration(0, 4)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,13 @@
+package uuid
+
+// GobEncode implements gob.GobEncoder using MarshalBinary. gob omits
+// zero-valued struct fields without calling it, so a zero field decodes back
+// to the zero value.
+func (u ValidatedUUID) GobEncode() ([]byte, error) {
+	return u.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary
+func (u *ValidatedUUID) GobDecode(data []byte) error {
+	return u.UnmarshalBinary(data)
+}
@@ -0,0 +1,53 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type gobEntry struct {
+	Key ValidatedUUID
+	Val string
+}
+
+func TestValidatedUUID_Gob(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("round trip", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(gobEntry{Key: u, Val: "cached"}))
+
+		var got gobEntry
+		require.NoError(t, gob.NewDecoder(&buf).Decode(&got))
+		assert.Equal(t, gobEntry{Key: u, Val: "cached"}, got)
+	})
+
+	t.Run("encode zero", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := gob.NewEncoder(&buf).Encode(ValidatedUUID{})
+		assert.ErrorIs(t, err, ErrNil)
+	})
+
+	t.Run("zero struct fields are omitted", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(gobEntry{Val: "cached"}))
+
+		var got gobEntry
+		require.NoError(t, gob.NewDecoder(&buf).Decode(&got))
+		assert.True(t, got.Key.IsZero())
+	})
+
+	t.Run("decode", func(t *testing.T) {
+		var got ValidatedUUID
+		require.NoError(t, got.GobDecode(u.UUID[:]))
+		assert.Equal(t, u, got)
+
+		assert.ErrorIs(t, got.GobDecode(make([]byte, 16)), ErrNil)
+		assert.ErrorIs(t, got.GobDecode(u.UUID[:8]), ErrInvalidFormat)
+		assert.Equal(t, u, got, "failed decodes leave the value unchanged")
+	})
+}
@@ -0,0 +1,34 @@
+package uuid
+
+import (
+	"io"
+	"strconv"
+)
+
+// MarshalGQL implements the gqlgen graphql.Marshaler interface, writing the
+// UUID as a GraphQL string. gqlgen offers no way to report an error here, so
+// the zero value is written as null.
+func (u ValidatedUUID) MarshalGQL(w io.Writer) {
+	if u.IsZero() {
+		_, _ = io.WriteString(w, "null")
+		return
+	}
+	_, _ = io.WriteString(w, strconv.Quote(u.String()))
+}
+
+// UnmarshalGQL implements the gqlgen graphql.Unmarshaler interface with
+// validation. Input values must be strings, as for the ID scalar.
+func (u *ValidatedUUID) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return validationFailed("GraphQL unmarshalling", invalidFormatf("expected string, got %T", v))
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return validationFailed("GraphQL unmarshalling", err)
+	}
+
+	*u = parsed
+	return nil
+}
@@ -0,0 +1,47 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalGQL(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	var buf bytes.Buffer
+	u.MarshalGQL(&buf)
+	assert.Equal(t, `"550e8400-e29b-41d4-a716-446655440000"`, buf.String())
+
+	buf.Reset()
+	ValidatedUUID{}.MarshalGQL(&buf)
+	assert.Equal(t, "null", buf.String())
+}
+
+func TestUnmarshalGQL(t *testing.T) {
+	var u ValidatedUUID
+	require.NoError(t, u.UnmarshalGQL("550e8400-e29b-41d4-a716-446655440000"))
+	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", u.String())
+
+	tests := []struct {
+		name string
+		in   interface{}
+		want error
+	}{
+		{"empty", "", ErrEmpty},
+		{"nil UUID", "00000000-0000-0000-0000-000000000000", ErrNil},
+		{"invalid", "invalid", ErrInvalidFormat},
+		{"not a string", 42, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var u ValidatedUUID
+			err := u.UnmarshalGQL(tt.in)
+			assert.ErrorIs(t, err, tt.want)
+			assert.True(t, u.IsZero())
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package uuid
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ringReplicas is the number of virtual points each node occupies on the hash ring
+const ringReplicas = 128
+
+// hash64 returns a 64-bit FNV-1a hash of the UUID bytes
+func (u ValidatedUUID) hash64() uint64 {
+	h := fnv.New64a()
+	h.Write(u.UUID[:])
+	return h.Sum64()
+}
+
+// ringPoint is a virtual node position on the consistent hash ring
+type ringPoint struct {
+	hash uint64
+	node string
+}
+
+// ConsistentHashNode returns the node owning the UUID on a consistent hash ring
+// built from nodes. Adding or removing a node only reassigns the UUIDs that
+// node gains or loses. The ring is rebuilt on every call.
+func (u ValidatedUUID) ConsistentHashNode(nodes []string) (string, error) {
+	if err := u.Validate(); err != nil {
+		return "", err
+	}
+	if len(nodes) == 0 {
+		return "", fmt.Errorf("nodes cannot be empty")
+	}
+
+	ring := make([]ringPoint, 0, len(nodes)*ringReplicas)
+	for _, node := range nodes {
+		for i := 0; i < ringReplicas; i++ {
+			h := fnv.New64a()
+			h.Write([]byte(node + "#" + strconv.Itoa(i)))
+			ring = append(ring, ringPoint{hash: h.Sum64(), node: node})
+		}
+	}
+	sort.Slice(ring, func(i, j int) bool {
+		if ring[i].hash != ring[j].hash {
+			return ring[i].hash < ring[j].hash
+		}
+		return ring[i].node < ring[j].node
+	})
+
+	key := u.hash64()
+	idx := sort.Search(len(ring), func(i int) bool { return ring[i].hash >= key })
+	if idx == len(ring) {
+		idx = 0
+	}
+	return ring[idx].node, nil
+}
+
+// Shard returns a stable shard index in [0, n) for the UUID, or 0 if n is not positive
+func (u ValidatedUUID) Shard(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return int(u.hash64() % uint64(n))
+}
+
+// PartitionByShard groups ids by their Shard(shards) index, preserving input
+// order within each group. It returns nil if shards is not positive.
+func PartitionByShard(ids []ValidatedUUID, shards int) map[int][]ValidatedUUID {
+	if shards <= 0 {
+		return nil
+	}
+
+	partitions := make(map[int][]ValidatedUUID)
+	for _, u := range ids {
+		shard := u.Shard(shards)
+		partitions[shard] = append(partitions[shard], u)
+	}
+	return partitions
+}
+
+// Jitter returns a stable duration in [0, max) derived from the UUID, so each
+// instance keyed by it picks the same offset for retries or scheduled starts.
+// It returns 0 if max is not positive.
+func (u ValidatedUUID) Jitter(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(u.hash64() % uint64(max))
+}
@@ -0,0 +1,135 @@
+package uuid
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatedUUID_ConsistentHashNode(t *testing.T) {
+	nodes := []string{"node-a", "node-b", "node-c", "node-d"}
+
+	t.Run("deterministic", func(t *testing.T) {
+		u := New()
+		first, err := u.ConsistentHashNode(nodes)
+		require.NoError(t, err)
+		second, err := u.ConsistentHashNode(nodes)
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+		assert.Contains(t, nodes, first)
+	})
+
+	t.Run("empty nodes fail", func(t *testing.T) {
+		_, err := New().ConsistentHashNode(nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("zero UUID fails", func(t *testing.T) {
+		_, err := ValidatedUUID{}.ConsistentHashNode(nodes)
+		assert.Error(t, err)
+	})
+
+	t.Run("minimal reassignment when a node is added", func(t *testing.T) {
+		const count = 2000
+		grown := append(append([]string{}, nodes...), "node-e")
+
+		moved := 0
+		for i := 0; i < count; i++ {
+			u := New()
+			before, err := u.ConsistentHashNode(nodes)
+			require.NoError(t, err)
+			after, err := u.ConsistentHashNode(grown)
+			require.NoError(t, err)
+
+			if before != after {
+				moved++
+				assert.Equal(t, "node-e", after, fmt.Sprintf("%s moved between existing nodes", u))
+			}
+		}
+
+		// Roughly 1/5 of the keys should move to the new node
+		assert.Greater(t, moved, 0)
+		assert.Less(t, moved, count*2/5)
+	})
+}
+
+func TestValidatedUUID_Shard(t *testing.T) {
+	u := New()
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, u.Shard(8), u.Shard(8))
+	}
+	assert.GreaterOrEqual(t, u.Shard(8), 0)
+	assert.Less(t, u.Shard(8), 8)
+	assert.Equal(t, 0, u.Shard(0))
+	assert.Equal(t, 0, u.Shard(-3))
+}
+
+func TestPartitionByShard(t *testing.T) {
+	t.Run("assignment and order", func(t *testing.T) {
+		ids := make([]ValidatedUUID, 200)
+		for i := range ids {
+			ids[i] = New()
+		}
+
+		partitions := PartitionByShard(ids, 4)
+		total := 0
+		for shard, bucket := range partitions {
+			total += len(bucket)
+			for _, u := range bucket {
+				assert.Equal(t, shard, u.Shard(4))
+			}
+
+			// Each bucket must be a subsequence of the input
+			pos := 0
+			for _, u := range bucket {
+				for pos < len(ids) && ids[pos] != u {
+					pos++
+				}
+				require.Less(t, pos, len(ids), "bucket order differs from input order")
+				pos++
+			}
+		}
+		assert.Equal(t, len(ids), total)
+	})
+
+	t.Run("non-positive shards", func(t *testing.T) {
+		assert.Nil(t, PartitionByShard([]ValidatedUUID{New()}, 0))
+		assert.Nil(t, PartitionByShard([]ValidatedUUID{New()}, -1))
+	})
+}
+
+func TestValidatedUUID_Jitter(t *testing.T) {
+	t.Run("deterministic", func(t *testing.T) {
+		u := New()
+		assert.Equal(t, u.Jitter(time.Minute), u.Jitter(time.Minute))
+	})
+
+	t.Run("range bounds", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			j := New().Jitter(time.Second)
+			assert.GreaterOrEqual(t, j, time.Duration(0))
+			assert.Less(t, j, time.Second)
+		}
+		assert.Zero(t, New().Jitter(time.Nanosecond))
+	})
+
+	t.Run("non-positive max", func(t *testing.T) {
+		assert.Zero(t, New().Jitter(0))
+		assert.Zero(t, New().Jitter(-time.Second))
+	})
+
+	t.Run("even distribution", func(t *testing.T) {
+		const buckets = 10
+		const samples = 10000
+		counts := make([]int, buckets)
+		for i := 0; i < samples; i++ {
+			counts[New().Jitter(buckets*time.Second)/time.Second]++
+		}
+		for b, c := range counts {
+			assert.InDelta(t, samples/buckets, c, samples/buckets*0.2, "bucket %d", b)
+		}
+	})
+}
@@ -1,7 +1,9 @@
 package uuid
 
 import (
+	"errors"
 	"fmt"
+
 	"github.com/google/uuid"
 )
 
@@ -57,7 +59,7 @@ func MustProtoToString(pb *UUID) string {
 // ValidateProtoUUID validates a protobuf UUID without conversion
 func ValidateProtoUUID(pb *UUID) error {
 	if pb == nil {
-		return fmt.Errorf("protobuf UUID cannot be nil")
+		return ErrNilProto
 	}
 	_, err := Parse(pb.GetVal())
 	return err
@@ -68,3 +70,41 @@ func ValidateStringUUID(s string) error {
 	_, err := Parse(s)
 	return err
 }
+
+// ProtosToUUIDs converts a repeated protobuf UUID field to ValidatedUUIDs. Every
+// invalid element is reported by index in the returned joined error.
+func ProtosToUUIDs(pbs []*UUID) ([]ValidatedUUID, error) {
+	ids := make([]ValidatedUUID, len(pbs))
+	var errs []error
+	for i, pb := range pbs {
+		u, err := FromProto(pb)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("index %d: %w", i, err))
+			continue
+		}
+		ids[i] = u
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return ids, nil
+}
+
+// UUIDsToProtos converts ValidatedUUIDs to a repeated protobuf UUID field.
+// Every invalid element is reported by index in the returned joined error.
+func UUIDsToProtos(ids []ValidatedUUID) ([]*UUID, error) {
+	pbs := make([]*UUID, len(ids))
+	var errs []error
+	for i, u := range ids {
+		pb, err := u.ToProto()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("index %d: %w", i, err))
+			continue
+		}
+		pbs[i] = pb
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return pbs, nil
+}
@@ -0,0 +1,40 @@
+package uuid
+
+// ID is a ValidatedUUID tagged with the entity type it identifies, so
+// ID[User] and ID[Order] cannot be mixed up at compile time. JSON, text,
+// binary, SQL and protobuf behavior is that of the embedded ValidatedUUID.
+type ID[T any] struct {
+	ValidatedUUID
+}
+
+// NewID creates a new random ID[T]
+func NewID[T any]() ID[T] {
+	return ID[T]{New()}
+}
+
+// ParseID parses a string into an ID[T] with validation
+func ParseID[T any](s string) (ID[T], error) {
+	u, err := Parse(s)
+	if err != nil {
+		return ID[T]{}, err
+	}
+	return ID[T]{u}, nil
+}
+
+// MustParseID parses a string into an ID[T], panicking on error
+func MustParseID[T any](s string) ID[T] {
+	id, err := ParseID[T](s)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// IDFromProto converts a protobuf UUID message to an ID[T] with validation
+func IDFromProto[T any](pb *UUID) (ID[T], error) {
+	u, err := FromProto(pb)
+	if err != nil {
+		return ID[T]{}, err
+	}
+	return ID[T]{u}, nil
+}
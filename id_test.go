@@ -0,0 +1,65 @@
+package uuid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testUser struct{}
+
+type testOrder struct{}
+
+func TestID(t *testing.T) {
+	t.Run("parse", func(t *testing.T) {
+		id, err := ParseID[testUser]("550e8400-e29b-41d4-a716-446655440000")
+		require.NoError(t, err)
+		assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", id.String())
+
+		_, err = ParseID[testUser]("invalid")
+		assert.Error(t, err)
+		assert.Panics(t, func() { MustParseID[testUser]("invalid") })
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type order struct {
+			ID     ID[testOrder] `json:"id"`
+			UserID ID[testUser]  `json:"user_id"`
+		}
+		in := order{ID: NewID[testOrder](), UserID: NewID[testUser]()}
+
+		data, err := json.Marshal(in)
+		require.NoError(t, err)
+
+		var out order
+		require.NoError(t, json.Unmarshal(data, &out))
+		assert.Equal(t, in, out)
+
+		assert.Error(t, json.Unmarshal([]byte(`{"id":"00000000-0000-0000-0000-000000000000"}`), &out))
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		id := NewID[testUser]()
+		v, err := id.Value()
+		require.NoError(t, err)
+
+		var scanned ID[testUser]
+		require.NoError(t, scanned.Scan(v))
+		assert.Equal(t, id, scanned)
+	})
+
+	t.Run("proto", func(t *testing.T) {
+		id := NewID[testUser]()
+		pb, err := id.ToProto()
+		require.NoError(t, err)
+
+		back, err := IDFromProto[testUser](pb)
+		require.NoError(t, err)
+		assert.Equal(t, id, back)
+
+		_, err = IDFromProto[testUser](nil)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,105 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// DecodeSingleIDBody decodes a JSON object such as {"id":"..."} from r and
+// returns the validated UUID stored under field. Other fields are ignored.
+func DecodeSingleIDBody(r io.Reader, field string) (ValidatedUUID, error) {
+	return decodeSingleIDBody(r, field, false)
+}
+
+// DecodeSingleIDBodyStrict is like DecodeSingleIDBody but rejects objects
+// containing any field other than field
+func DecodeSingleIDBodyStrict(r io.Reader, field string) (ValidatedUUID, error) {
+	return decodeSingleIDBody(r, field, true)
+}
+
+func decodeSingleIDBody(r io.Reader, field string, strict bool) (ValidatedUUID, error) {
+	var body map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&body); err != nil {
+		return ValidatedUUID{}, fmt.Errorf("invalid JSON body: %w", err)
+	}
+	if body == nil {
+		return ValidatedUUID{}, fmt.Errorf("JSON body must be an object")
+	}
+
+	if strict {
+		var unknown []string
+		for k := range body {
+			if k != field {
+				unknown = append(unknown, k)
+			}
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			return ValidatedUUID{}, fmt.Errorf("unknown fields in JSON body: %s", strings.Join(unknown, ", "))
+		}
+	}
+
+	raw, ok := body[field]
+	if !ok {
+		return ValidatedUUID{}, fmt.Errorf("field %q is missing", field)
+	}
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return ValidatedUUID{}, fmt.Errorf("field %q cannot be null", field)
+	}
+
+	var u ValidatedUUID
+	if err := json.Unmarshal(raw, &u); err != nil {
+		var verr *ValidationError
+		if errors.As(err, &verr) {
+			verr.Field = field
+			return ValidatedUUID{}, verr
+		}
+		return ValidatedUUID{}, fmt.Errorf("field %q: %w", field, err)
+	}
+	return u, nil
+}
+
+// ValidateJSONArray checks that data is a JSON array whose elements are all
+// valid UUID strings, streaming tokens instead of materializing the slice. It
+// returns the number of valid elements seen before the first failure, and an
+// error naming the index of that failure.
+func ValidateJSONArray(data []byte) (int, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return 0, fmt.Errorf("invalid JSON array: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return 0, fmt.Errorf("invalid JSON array: expected '[', got %v", tok)
+	}
+
+	count := 0
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return count, fmt.Errorf("invalid JSON array at index %d: %w", count, err)
+		}
+		s, ok := tok.(string)
+		if !ok {
+			return count, fmt.Errorf("element at index %d is not a string", count)
+		}
+		if _, err := Parse(s); err != nil {
+			return count, fmt.Errorf("element at index %d: %w", count, err)
+		}
+		count++
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return count, fmt.Errorf("invalid JSON array: %w", err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return count, fmt.Errorf("invalid JSON array: unexpected data after array")
+	}
+	return count, nil
+}
@@ -0,0 +1,101 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeSingleIDBody(t *testing.T) {
+	validUUIDStr := "550e8400-e29b-41d4-a716-446655440000"
+
+	t.Run("valid body", func(t *testing.T) {
+		u, err := DecodeSingleIDBody(strings.NewReader(`{"id":"`+validUUIDStr+`"}`), "id")
+		require.NoError(t, err)
+		assert.Equal(t, validUUIDStr, u.String())
+	})
+
+	t.Run("extra fields are ignored", func(t *testing.T) {
+		u, err := DecodeSingleIDBody(strings.NewReader(`{"id":"`+validUUIDStr+`","name":"x"}`), "id")
+		require.NoError(t, err)
+		assert.Equal(t, validUUIDStr, u.String())
+	})
+
+	t.Run("extra fields rejected in strict mode", func(t *testing.T) {
+		_, err := DecodeSingleIDBodyStrict(strings.NewReader(`{"id":"`+validUUIDStr+`","name":"x"}`), "id")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown fields in JSON body: name")
+
+		u, err := DecodeSingleIDBodyStrict(strings.NewReader(`{"id":"`+validUUIDStr+`"}`), "id")
+		require.NoError(t, err)
+		assert.Equal(t, validUUIDStr, u.String())
+	})
+
+	t.Run("missing field", func(t *testing.T) {
+		_, err := DecodeSingleIDBody(strings.NewReader(`{"other":"`+validUUIDStr+`"}`), "id")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `field "id" is missing`)
+	})
+
+	t.Run("null field", func(t *testing.T) {
+		_, err := DecodeSingleIDBody(strings.NewReader(`{"id":null}`), "id")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be null")
+	})
+
+	t.Run("malformed field", func(t *testing.T) {
+		_, err := DecodeSingleIDBody(strings.NewReader(`{"id":"not-a-uuid"}`), "id")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "validation failed")
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		for _, body := range []string{`null`, `[]`, `"x"`, `{`} {
+			_, err := DecodeSingleIDBody(strings.NewReader(body), "id")
+			assert.Error(t, err, body)
+		}
+	})
+}
+
+func TestValidateJSONArray(t *testing.T) {
+	a := New().String()
+	b := New().String()
+
+	t.Run("all valid", func(t *testing.T) {
+		count, err := ValidateJSONArray([]byte(`["` + a + `", "` + b + `"]`))
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("empty array", func(t *testing.T) {
+		count, err := ValidateJSONArray([]byte(`[]`))
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("one invalid element", func(t *testing.T) {
+		count, err := ValidateJSONArray([]byte(`["` + a + `", "not-a-uuid", "` + b + `"]`))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "index 1")
+		assert.Contains(t, err.Error(), "invalid UUID format")
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("non-string element", func(t *testing.T) {
+		for _, elem := range []string{`42`, `null`, `{"id":"` + a + `"}`, `["` + a + `"]`} {
+			count, err := ValidateJSONArray([]byte(`["` + a + `", ` + elem + `]`))
+			assert.Error(t, err, elem)
+			assert.Contains(t, err.Error(), "index 1 is not a string")
+			assert.Equal(t, 1, count)
+		}
+	})
+
+	t.Run("not an array", func(t *testing.T) {
+		for _, data := range []string{``, `{}`, `"` + a + `"`, `["` + a + `"`, `["` + a + `"] []`} {
+			_, err := ValidateJSONArray([]byte(data))
+			assert.Error(t, err, data)
+		}
+	})
+}
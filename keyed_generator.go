@@ -0,0 +1,67 @@
+package uuid
+
+import (
+	"sync"
+	"time"
+)
+
+// keyedSweepInterval is how many new keys KeyedGenerator assigns between
+// sweeps of expired entries
+const keyedSweepInterval = 1024
+
+// keyedEntry is a cached UUID and the moment it stops being reused
+type keyedEntry struct {
+	id      ValidatedUUID
+	expires time.Time
+}
+
+// KeyedGenerator hands out one UUID per key for a caller-chosen window, such as
+// idempotency keys that must map to the same ID on retry. The zero value is
+// ready to use. It is safe for concurrent use.
+type KeyedGenerator struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	entries map[string]keyedEntry
+	added   int
+}
+
+// NewKeyedGenerator creates an empty KeyedGenerator
+func NewKeyedGenerator() *KeyedGenerator {
+	return &KeyedGenerator{
+		now:     time.Now,
+		entries: make(map[string]keyedEntry),
+	}
+}
+
+// ForKey returns the UUID cached for key, or generates and caches a new v4 UUID
+// for ttl if there is none or the previous one has expired. Expired entries are
+// swept periodically so abandoned keys do not accumulate.
+func (g *KeyedGenerator) ForKey(key string, ttl time.Duration) ValidatedUUID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.now == nil {
+		g.now = time.Now
+	}
+	if g.entries == nil {
+		g.entries = make(map[string]keyedEntry)
+	}
+
+	now := g.now()
+	if e, ok := g.entries[key]; ok && now.Before(e.expires) {
+		return e.id
+	}
+
+	g.added++
+	if g.added%keyedSweepInterval == 0 {
+		for k, e := range g.entries {
+			if !now.Before(e.expires) {
+				delete(g.entries, k)
+			}
+		}
+	}
+
+	id := New()
+	g.entries[key] = keyedEntry{id: id, expires: now.Add(ttl)}
+	return id
+}
@@ -0,0 +1,70 @@
+package uuid
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedGenerator(t *testing.T) {
+	t.Run("same key yields same UUID concurrently", func(t *testing.T) {
+		g := NewKeyedGenerator()
+
+		const workers = 32
+		results := make([]ValidatedUUID, workers)
+		var wg sync.WaitGroup
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i] = g.ForKey("order-42", time.Minute)
+			}(i)
+		}
+		wg.Wait()
+
+		for _, u := range results {
+			assert.Equal(t, results[0], u)
+		}
+		assert.NoError(t, results[0].Validate())
+		assert.NotEqual(t, results[0], g.ForKey("order-43", time.Minute))
+	})
+
+	t.Run("expires after ttl", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		g := NewKeyedGenerator()
+		g.now = func() time.Time { return now }
+
+		first := g.ForKey("k", time.Second)
+		now = now.Add(999 * time.Millisecond)
+		assert.Equal(t, first, g.ForKey("k", time.Second))
+
+		now = now.Add(time.Millisecond)
+		second := g.ForKey("k", time.Second)
+		assert.NotEqual(t, first, second)
+		assert.Equal(t, second, g.ForKey("k", time.Second))
+	})
+
+	t.Run("sweeps expired keys", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		g := NewKeyedGenerator()
+		g.now = func() time.Time { return now }
+
+		g.ForKey("stale", time.Second)
+		now = now.Add(time.Minute)
+		for i := 1; i < keyedSweepInterval; i++ {
+			g.ForKey(fmt.Sprintf("key-%d", i), time.Hour)
+		}
+		_, ok := g.entries["stale"]
+		assert.False(t, ok)
+	})
+	t.Run("zero value", func(t *testing.T) {
+		var g KeyedGenerator
+		first := g.ForKey("k", time.Hour)
+		assert.False(t, first.IsZero())
+		assert.Equal(t, first, g.ForKey("k", time.Hour))
+		assert.NotEqual(t, first, g.ForKey("other", time.Hour))
+	})
+}
@@ -0,0 +1,16 @@
+package uuid
+
+import "log/slog"
+
+// NilLogValue is the value logged for the zero ValidatedUUID, distinguishable
+// from any real UUID
+const NilLogValue = "nil-uuid"
+
+// LogValue implements slog.LogValuer, logging the canonical string form. The
+// zero value logs as NilLogValue rather than failing like MarshalJSON.
+func (u ValidatedUUID) LogValue() slog.Value {
+	if u.IsZero() {
+		return slog.StringValue(NilLogValue)
+	}
+	return slog.StringValue(u.String())
+}
@@ -0,0 +1,29 @@
+package uuid
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogValue(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	assert.Equal(t, slog.KindString, u.LogValue().Kind())
+	assert.Equal(t, u.String(), u.LogValue().String())
+	assert.Equal(t, NilLogValue, ValidatedUUID{}.LogValue().String())
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("lookup", "id", u, "parent", ValidatedUUID{})
+	assert.JSONEq(t, `{"level":"INFO","msg":"lookup","id":"550e8400-e29b-41d4-a716-446655440000","parent":"nil-uuid"}`, buf.String())
+}
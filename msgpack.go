@@ -0,0 +1,63 @@
+package uuid
+
+// MessagePack format bytes used by the msgpack methods
+const (
+	msgpackBin8   byte = 0xc4
+	msgpackStr8   byte = 0xd9
+	msgpackFixstr byte = 0xa0 // low 5 bits hold the length
+)
+
+// MarshalMsgpack implements the msgpack.Marshaler interface of
+// vmihailenco/msgpack, encoding the UUID as a 16-byte bin 8 value
+func (u ValidatedUUID) MarshalMsgpack() ([]byte, error) {
+	if err := u.Validate(); err != nil {
+		return nil, validationFailed("msgpack marshalling", err)
+	}
+
+	data := make([]byte, 0, 2+16)
+	data = append(data, msgpackBin8, 16)
+	return append(data, u.UUID[:]...), nil
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface of
+// vmihailenco/msgpack with validation. Besides the 16-byte binary form it
+// accepts strings in any form Parse accepts, so values written before the
+// binary encoding still decode.
+func (u *ValidatedUUID) UnmarshalMsgpack(data []byte) error {
+	parsed, err := decodeMsgpack(data)
+	if err != nil {
+		return validationFailed("msgpack unmarshalling", err)
+	}
+
+	*u = parsed
+	return nil
+}
+
+// decodeMsgpack decodes a msgpack bin 8 or string value into a UUID
+func decodeMsgpack(data []byte) (ValidatedUUID, error) {
+	if len(data) == 0 {
+		return ValidatedUUID{}, ErrEmpty
+	}
+
+	switch tag := data[0]; {
+	case tag == msgpackBin8:
+		if len(data) != 2+16 || data[1] != 16 {
+			return ValidatedUUID{}, invalidFormatf("expected 16-byte msgpack binary")
+		}
+		var b [16]byte
+		copy(b[:], data[2:])
+		return FromGoogleUUID(b)
+	case tag&0xe0 == msgpackFixstr:
+		if len(data) != 1+int(tag&0x1f) {
+			return ValidatedUUID{}, invalidFormatf("malformed msgpack string")
+		}
+		return Parse(string(data[1:]))
+	case tag == msgpackStr8:
+		if len(data) < 2 || len(data) != 2+int(data[1]) {
+			return ValidatedUUID{}, invalidFormatf("malformed msgpack string")
+		}
+		return Parse(string(data[2:]))
+	default:
+		return ValidatedUUID{}, invalidFormatf("unsupported msgpack type 0x%02x", tag)
+	}
+}
@@ -0,0 +1,69 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type msgpackEvent struct {
+	ID       ValidatedUUID `msgpack:"id"`
+	TenantID ValidatedUUID `msgpack:"tenant_id"`
+}
+
+func TestValidatedUUID_Msgpack(t *testing.T) {
+	id := MustParse("550e8400-e29b-41d4-a716-446655440000")
+	tenant := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	t.Run("binary form", func(t *testing.T) {
+		data, err := id.MarshalMsgpack()
+		require.NoError(t, err)
+		assert.Equal(t, append([]byte{0xc4, 16}, id.UUID[:]...), data)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		data, err := msgpack.Marshal(msgpackEvent{ID: id, TenantID: tenant})
+		require.NoError(t, err)
+
+		var event msgpackEvent
+		require.NoError(t, msgpack.Unmarshal(data, &event))
+		assert.Equal(t, msgpackEvent{ID: id, TenantID: tenant}, event)
+	})
+
+	t.Run("legacy string form", func(t *testing.T) {
+		data, err := msgpack.Marshal(map[string]string{"id": id.String(), "tenant_id": tenant.String()})
+		require.NoError(t, err)
+
+		var event msgpackEvent
+		require.NoError(t, msgpack.Unmarshal(data, &event))
+		assert.Equal(t, msgpackEvent{ID: id, TenantID: tenant}, event)
+	})
+
+	t.Run("marshal zero", func(t *testing.T) {
+		_, err := msgpack.Marshal(msgpackEvent{ID: id})
+		assert.ErrorIs(t, err, ErrNil)
+	})
+
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"empty", nil, ErrEmpty},
+		{"nil UUID", append([]byte{0xc4, 16}, make([]byte, 16)...), ErrNil},
+		{"short binary", []byte{0xc4, 2, 1, 2}, ErrInvalidFormat},
+		{"invalid string", []byte{0xa7, 'i', 'n', 'v', 'a', 'l', 'i', 'd'}, ErrInvalidFormat},
+		{"truncated string", []byte{0xd9, 36, '5'}, ErrInvalidFormat},
+		{"integer", []byte{0x01}, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var u ValidatedUUID
+			assert.ErrorIs(t, u.UnmarshalMsgpack(tt.data), tt.want)
+			assert.True(t, u.IsZero())
+		})
+	}
+}
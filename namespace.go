@@ -0,0 +1,86 @@
+package uuid
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Well-known namespaces from RFC 4122 for use with NewV3 and NewV5
+var (
+	NamespaceDNS  = ValidatedUUID{UUID: uuid.NameSpaceDNS}
+	NamespaceURL  = ValidatedUUID{UUID: uuid.NameSpaceURL}
+	NamespaceOID  = ValidatedUUID{UUID: uuid.NameSpaceOID}
+	NamespaceX500 = ValidatedUUID{UUID: uuid.NameSpaceX500}
+)
+
+// NewV5 derives a deterministic SHA-1 based v5 UUID from namespace and name
+func NewV5(namespace ValidatedUUID, name []byte) (ValidatedUUID, error) {
+	if err := namespace.Validate(); err != nil {
+		return ValidatedUUID{}, fmt.Errorf("invalid namespace: %w", err)
+	}
+	return ValidatedUUID{UUID: uuid.NewSHA1(namespace.UUID, name)}, nil
+}
+
+// NewV3 derives a deterministic MD5 based v3 UUID from namespace and name.
+// Prefer NewV5 unless interoperating with systems that require v3.
+func NewV3(namespace ValidatedUUID, name []byte) (ValidatedUUID, error) {
+	if err := namespace.Validate(); err != nil {
+		return ValidatedUUID{}, fmt.Errorf("invalid namespace: %w", err)
+	}
+	return ValidatedUUID{UUID: uuid.NewMD5(namespace.UUID, name)}, nil
+}
+
+// NamespaceTree is a hierarchy of v5 namespaces in which each child is derived
+// from its parent's UUID and its name. Derived children are cached, so
+// repeated traversals return the same nodes. It is safe for concurrent use.
+type NamespaceTree struct {
+	id ValidatedUUID
+
+	mu       sync.Mutex
+	children map[string]*NamespaceTree
+}
+
+// NewNamespaceTree creates a tree rooted at root
+func NewNamespaceTree(root ValidatedUUID) *NamespaceTree {
+	return &NamespaceTree{id: root}
+}
+
+// UUID returns the namespace UUID of this node
+func (t *NamespaceTree) UUID() ValidatedUUID {
+	return t.id
+}
+
+// Child returns the node for name beneath t, deriving it as
+// NewSHA1(t.UUID(), name) on first use
+func (t *NamespaceTree) Child(name string) *NamespaceTree {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if child, ok := t.children[name]; ok {
+		return child
+	}
+	if t.children == nil {
+		t.children = make(map[string]*NamespaceTree)
+	}
+
+	child := &NamespaceTree{id: ValidatedUUID{UUID: uuid.NewSHA1(t.id.UUID, []byte(name))}}
+	t.children[name] = child
+	return child
+}
+
+// NewV5Batch derives one v5 UUID per name within namespace, preserving order.
+// Identical names yield identical UUIDs; callers that need unique results
+// should dedupe the names or the output.
+func NewV5Batch(namespace ValidatedUUID, names []string) ([]ValidatedUUID, error) {
+	if err := namespace.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid namespace: %w", err)
+	}
+
+	ids := make([]ValidatedUUID, len(names))
+	for i, name := range names {
+		ids[i] = ValidatedUUID{UUID: uuid.NewSHA1(namespace.UUID, []byte(name))}
+	}
+	return ids, nil
+}
@@ -0,0 +1,117 @@
+package uuid
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceTree(t *testing.T) {
+	root := MustFromGoogleUUID(uuid.NameSpaceDNS)
+
+	t.Run("derives v5 children", func(t *testing.T) {
+		tree := NewNamespaceTree(root)
+		assert.Equal(t, root, tree.UUID())
+
+		child := tree.Child("acme")
+		assert.Equal(t, uuid.NewSHA1(uuid.NameSpaceDNS, []byte("acme")), child.UUID().UUID)
+		assert.Equal(t, 5, int(child.UUID().UUID.Version()))
+	})
+
+	t.Run("caching returns identical values", func(t *testing.T) {
+		tree := NewNamespaceTree(root)
+		first := tree.Child("acme").Child("billing").Child("invoice")
+		second := tree.Child("acme").Child("billing").Child("invoice")
+		assert.Same(t, first, second)
+		assert.Equal(t, first.UUID(), second.UUID())
+
+		fresh := NewNamespaceTree(root).Child("acme").Child("billing").Child("invoice")
+		assert.Equal(t, first.UUID(), fresh.UUID())
+	})
+
+	t.Run("paths are order-sensitive", func(t *testing.T) {
+		tree := NewNamespaceTree(root)
+		ab := tree.Child("a").Child("b")
+		ba := tree.Child("b").Child("a")
+		assert.NotEqual(t, ab.UUID(), ba.UUID())
+	})
+
+	t.Run("concurrent traversal", func(t *testing.T) {
+		tree := NewNamespaceTree(root)
+		results := make([]*NamespaceTree, 16)
+
+		var wg sync.WaitGroup
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i] = tree.Child("org").Child("project")
+			}(i)
+		}
+		wg.Wait()
+
+		for _, r := range results {
+			assert.Same(t, results[0], r)
+		}
+	})
+}
+
+func TestNewV5Batch(t *testing.T) {
+	namespace := MustFromGoogleUUID(uuid.NameSpaceURL)
+
+	t.Run("preserves order", func(t *testing.T) {
+		names := []string{"alice@example.com", "bob@example.com", "carol@example.com"}
+		ids, err := NewV5Batch(namespace, names)
+		require.NoError(t, err)
+		require.Len(t, ids, len(names))
+
+		for i, name := range names {
+			assert.Equal(t, uuid.NewSHA1(uuid.NameSpaceURL, []byte(name)), ids[i].UUID)
+			assert.Equal(t, 5, int(ids[i].UUID.Version()))
+		}
+	})
+
+	t.Run("identical names map to identical UUIDs", func(t *testing.T) {
+		ids, err := NewV5Batch(namespace, []string{"x", "y", "x"})
+		require.NoError(t, err)
+		assert.Equal(t, ids[0], ids[2])
+		assert.NotEqual(t, ids[0], ids[1])
+	})
+
+	t.Run("empty names", func(t *testing.T) {
+		ids, err := NewV5Batch(namespace, nil)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("zero namespace fails", func(t *testing.T) {
+		_, err := NewV5Batch(ValidatedUUID{}, []string{"x"})
+		assert.Error(t, err)
+	})
+}
+
+func TestNewV5(t *testing.T) {
+	u, err := NewV5(NamespaceDNS, []byte("www.example.com"))
+	require.NoError(t, err)
+	assert.Equal(t, "2ed6657d-e927-568b-95e1-2665a8aea6a2", u.String())
+
+	again, err := NewV5(NamespaceDNS, []byte("www.example.com"))
+	require.NoError(t, err)
+	assert.Equal(t, u, again)
+
+	_, err = NewV5(ValidatedUUID{}, []byte("www.example.com"))
+	assert.Error(t, err)
+}
+
+func TestNewV3(t *testing.T) {
+	u, err := NewV3(NamespaceDNS, []byte("www.example.com"))
+	require.NoError(t, err)
+	assert.Equal(t, "5df41881-3aed-3515-88a7-2f4a814cf09e", u.String())
+	assert.Equal(t, uuid.Version(3), u.UUID.Version())
+
+	_, err = NewV3(ValidatedUUID{}, []byte("www.example.com"))
+	assert.Error(t, err)
+}
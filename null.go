@@ -0,0 +1,128 @@
+package uuid
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+)
+
+// NullUUID represents a ValidatedUUID that may be null, for nullable columns
+type NullUUID struct {
+	UUID  ValidatedUUID
+	Valid bool // Valid is true if UUID is not NULL
+}
+
+// NullValidatedUUID is an alias of NullUUID, named after sql.NullString and
+// friends
+type NullValidatedUUID = NullUUID
+
+// Scan implements sql.Scanner, mapping NULL to an invalid NullUUID
+func (n *NullUUID) Scan(value interface{}) error {
+	if value == nil {
+		*n = NullUUID{}
+		return nil
+	}
+
+	if err := n.UUID.Scan(value); err != nil {
+		*n = NullUUID{}
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer, writing NULL when the UUID is not valid
+func (n NullUUID) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.UUID.Value()
+}
+
+// MarshalJSON implements json.Marshaler, writing null when the UUID is not valid
+func (n NullUUID) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.UUID.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, mapping null to an invalid NullUUID
+func (n *NullUUID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*n = NullUUID{}
+		return nil
+	}
+
+	var u ValidatedUUID
+	if err := u.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*n = NullUUID{UUID: u, Valid: true}
+	return nil
+}
+
+// ToProto converts n to a protobuf UUID message, returning nil when the UUID
+// is not valid so the field is left unset
+func (n NullUUID) ToProto() (*UUID, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.UUID.ToProto()
+}
+
+// NullFromProto converts a protobuf UUID message to a NullUUID. A nil message
+// or one with an empty value yields an invalid NullUUID; anything else must
+// hold a valid UUID.
+func NullFromProto(pb *UUID) (NullUUID, error) {
+	if pb.GetVal() == "" {
+		return NullUUID{}, nil
+	}
+
+	u, err := FromProto(pb)
+	if err != nil {
+		return NullUUID{}, err
+	}
+	return NullUUID{UUID: u, Valid: true}, nil
+}
+
+// Get returns the UUID and whether it is valid
+func (n NullUUID) Get() (ValidatedUUID, bool) {
+	if !n.Valid {
+		return ValidatedUUID{}, false
+	}
+	return n.UUID, true
+}
+
+// Equal reports whether n is valid and holds u
+func (n NullUUID) Equal(u ValidatedUUID) bool {
+	return n.Valid && n.UUID == u
+}
+
+// FromProtoOptional maps an optional proto3 UUID field to a NullUUID. An
+// absent field yields an invalid NullUUID; a present field must hold a valid
+// UUID.
+func FromProtoOptional(pb *UUID, present bool) (NullUUID, error) {
+	if !present {
+		return NullUUID{}, nil
+	}
+	if pb == nil {
+		return NullUUID{}, fmt.Errorf("protobuf UUID cannot be nil when present")
+	}
+
+	u, err := FromProto(pb)
+	if err != nil {
+		return NullUUID{}, err
+	}
+	return NullUUID{UUID: u, Valid: true}, nil
+}
+
+// NullFromResult turns a (ValidatedUUID, error) result into a NullUUID. A
+// non-nil err is deliberately swallowed and yields an invalid NullUUID, so a
+// failed parse simply means "absent"; use it only where that is intended.
+func NullFromResult(u ValidatedUUID, err error) NullUUID {
+	if err != nil || u.IsZero() {
+		return NullUUID{}
+	}
+	return NullUUID{UUID: u, Valid: true}
+}
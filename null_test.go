@@ -0,0 +1,181 @@
+package uuid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullUUID_SQL(t *testing.T) {
+	t.Run("scan NULL", func(t *testing.T) {
+		n := NullUUID{UUID: New(), Valid: true}
+		require.NoError(t, n.Scan(nil))
+		assert.False(t, n.Valid)
+
+		value, err := n.Value()
+		require.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("scan value", func(t *testing.T) {
+		u := New()
+		var n NullUUID
+		require.NoError(t, n.Scan(u.String()))
+		assert.True(t, n.Valid)
+		assert.Equal(t, u, n.UUID)
+
+		value, err := n.Value()
+		require.NoError(t, err)
+		assert.Equal(t, u.String(), value)
+	})
+
+	t.Run("scan invalid fails", func(t *testing.T) {
+		var n NullUUID
+		assert.Error(t, n.Scan("not-a-uuid"))
+		assert.False(t, n.Valid)
+	})
+}
+
+func TestNullUUID_JSON(t *testing.T) {
+	type record struct {
+		ParentID NullValidatedUUID `json:"parent_id"`
+	}
+
+	t.Run("null round trip", func(t *testing.T) {
+		data, err := json.Marshal(record{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"parent_id":null}`, string(data))
+
+		r := record{ParentID: NullUUID{UUID: New(), Valid: true}}
+		require.NoError(t, json.Unmarshal(data, &r))
+		assert.False(t, r.ParentID.Valid)
+	})
+
+	t.Run("value round trip", func(t *testing.T) {
+		u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+		data, err := json.Marshal(record{ParentID: NullUUID{UUID: u, Valid: true}})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"parent_id":"550e8400-e29b-41d4-a716-446655440000"}`, string(data))
+
+		var r record
+		require.NoError(t, json.Unmarshal(data, &r))
+		assert.True(t, r.ParentID.Equal(u))
+	})
+
+	t.Run("invalid fails", func(t *testing.T) {
+		var r record
+		assert.Error(t, json.Unmarshal([]byte(`{"parent_id":"nope"}`), &r))
+		assert.Error(t, json.Unmarshal([]byte(`{"parent_id":"00000000-0000-0000-0000-000000000000"}`), &r))
+	})
+}
+
+func TestNullUUID_Proto(t *testing.T) {
+	t.Run("null", func(t *testing.T) {
+		pb, err := NullUUID{}.ToProto()
+		require.NoError(t, err)
+		assert.Nil(t, pb)
+
+		n, err := NullFromProto(nil)
+		require.NoError(t, err)
+		assert.False(t, n.Valid)
+
+		n, err = NullFromProto(&UUID{})
+		require.NoError(t, err)
+		assert.False(t, n.Valid)
+	})
+
+	t.Run("value", func(t *testing.T) {
+		u := New()
+		pb, err := NullUUID{UUID: u, Valid: true}.ToProto()
+		require.NoError(t, err)
+		assert.Equal(t, u.String(), pb.GetVal())
+
+		n, err := NullFromProto(pb)
+		require.NoError(t, err)
+		assert.True(t, n.Equal(u))
+	})
+
+	t.Run("invalid fails", func(t *testing.T) {
+		_, err := NullFromProto(&UUID{Val: "nope"})
+		assert.Error(t, err)
+	})
+}
+
+func TestNullUUID_Equal(t *testing.T) {
+	u := New()
+
+	t.Run("null vs value", func(t *testing.T) {
+		assert.False(t, NullUUID{}.Equal(u))
+		assert.False(t, NullUUID{UUID: u}.Equal(u))
+	})
+
+	t.Run("value vs different value", func(t *testing.T) {
+		assert.False(t, NullUUID{UUID: New(), Valid: true}.Equal(u))
+	})
+
+	t.Run("matching", func(t *testing.T) {
+		assert.True(t, NullUUID{UUID: u, Valid: true}.Equal(u))
+	})
+}
+
+func TestNullUUID_Get(t *testing.T) {
+	u := New()
+
+	got, ok := NullUUID{UUID: u, Valid: true}.Get()
+	assert.True(t, ok)
+	assert.Equal(t, u, got)
+
+	got, ok = NullUUID{UUID: u}.Get()
+	assert.False(t, ok)
+	assert.True(t, got.IsZero())
+}
+
+func TestFromProtoOptional(t *testing.T) {
+	t.Run("absent", func(t *testing.T) {
+		n, err := FromProtoOptional(nil, false)
+		require.NoError(t, err)
+		assert.False(t, n.Valid)
+
+		n, err = FromProtoOptional(&UUID{Val: "ignored"}, false)
+		require.NoError(t, err)
+		assert.False(t, n.Valid)
+	})
+
+	t.Run("present and valid", func(t *testing.T) {
+		u := New()
+		n, err := FromProtoOptional(u.MustToProto(), true)
+		require.NoError(t, err)
+		assert.True(t, n.Equal(u))
+	})
+
+	t.Run("present but nil", func(t *testing.T) {
+		_, err := FromProtoOptional(nil, true)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be nil")
+	})
+
+	t.Run("present but invalid", func(t *testing.T) {
+		_, err := FromProtoOptional(&UUID{Val: "not-a-uuid"}, true)
+		assert.Error(t, err)
+	})
+}
+
+func TestNullFromResult(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		u := New()
+		n := NullFromResult(Parse(u.String()))
+		assert.True(t, n.Equal(u))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		n := NullFromResult(Parse("not-a-uuid"))
+		assert.False(t, n.Valid)
+		assert.True(t, n.UUID.IsZero())
+	})
+
+	t.Run("zero without error", func(t *testing.T) {
+		assert.False(t, NullFromResult(ValidatedUUID{}, nil).Valid)
+	})
+}
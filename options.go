@@ -0,0 +1,130 @@
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ParseOptions describes a policy applied on top of the default Parse rules
+type ParseOptions struct {
+	// Versions lists the accepted UUID versions; empty accepts any version
+	Versions []int
+	// RFC4122Only rejects UUIDs whose variant is not RFC 4122
+	RFC4122Only bool
+	// Reject lists UUIDs that are never accepted, such as known test vectors
+	Reject []ValidatedUUID
+	// AllowNil accepts the nil UUID, returning the zero ValidatedUUID
+	AllowNil bool
+	// CaseSensitive rejects input containing uppercase letters
+	CaseSensitive bool
+	// DisallowURN rejects the urn:uuid: form
+	DisallowURN bool
+}
+
+// ParseOption configures the ParseOptions used by ParseWith
+type ParseOption func(*ParseOptions)
+
+// KnownTestVectors are example UUIDs from documentation and the RFC that
+// frequently leak into real data through copy-paste. The list may be extended
+// during initialization.
+var KnownTestVectors = []ValidatedUUID{
+	MustParse("550e8400-e29b-41d4-a716-446655440000"), // ubiquitous documentation example
+	MustParse("123e4567-e89b-12d3-a456-426614174000"), // common documentation example
+	MustParse("f81d4fae-7dec-11d0-a765-00a0c91e6bf6"), // RFC 4122 URN example
+	MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), // RFC 4122 DNS namespace
+	MustParse("6ba7b811-9dad-11d1-80b4-00c04fd430c8"), // RFC 4122 URL namespace
+	MustParse("6ba7b812-9dad-11d1-80b4-00c04fd430c8"), // RFC 4122 OID namespace
+	MustParse("6ba7b814-9dad-11d1-80b4-00c04fd430c8"), // RFC 4122 X.500 namespace
+}
+
+// WithRejectKnownTestVectors rejects the given UUIDs, or KnownTestVectors if
+// none are given, to catch placeholder IDs copied from examples
+func WithRejectKnownTestVectors(vectors ...ValidatedUUID) ParseOption {
+	return func(o *ParseOptions) {
+		if len(vectors) == 0 {
+			o.Reject = append(o.Reject, KnownTestVectors...)
+			return
+		}
+		o.Reject = append(o.Reject, vectors...)
+	}
+}
+
+// AllowNil accepts the nil UUID instead of failing with ErrNil. The result is
+// the zero ValidatedUUID, which fails Validate, so callers must check IsZero
+// before using it. The other policy checks are skipped for the nil UUID.
+func AllowNil() ParseOption {
+	return func(o *ParseOptions) {
+		o.AllowNil = true
+	}
+}
+
+// RequireVersion accepts only UUIDs of the given versions
+func RequireVersion(versions ...int) ParseOption {
+	return func(o *ParseOptions) {
+		o.Versions = append(o.Versions, versions...)
+	}
+}
+
+// CaseSensitive accepts only lowercase input, rejecting uppercase hex digits
+// and URN prefixes
+func CaseSensitive() ParseOption {
+	return func(o *ParseOptions) {
+		o.CaseSensitive = true
+	}
+}
+
+// DisallowURNForm rejects the urn:uuid: form
+func DisallowURNForm() ParseOption {
+	return func(o *ParseOptions) {
+		o.DisallowURN = true
+	}
+}
+
+// ParseWith parses s like Parse and then enforces the policy built from opts
+func ParseWith(s string, opts ...ParseOption) (ValidatedUUID, error) {
+	var o ParseOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return ParseWithOptions(s, o)
+}
+
+// ParseWithOptions parses s like Parse and then enforces the policy in opts
+func ParseWithOptions(s string, opts ParseOptions) (ValidatedUUID, error) {
+	if opts.DisallowURN && len(s) == 36+len(urnPrefix) && strings.EqualFold(s[:len(urnPrefix)], urnPrefix) {
+		return ValidatedUUID{}, invalidFormatf("URN form is not allowed")
+	}
+	if opts.CaseSensitive && strings.ContainsFunc(s, func(r rune) bool { return 'A' <= r && r <= 'Z' }) {
+		return ValidatedUUID{}, invalidFormatf("uppercase characters are not allowed")
+	}
+
+	u, err := Parse(s)
+	if err != nil {
+		if opts.AllowNil && errors.Is(err, ErrNil) {
+			return ValidatedUUID{}, nil
+		}
+		return ValidatedUUID{}, err
+	}
+	if err := opts.check(u); err != nil {
+		return ValidatedUUID{}, err
+	}
+	return u, nil
+}
+
+// check enforces the policy on an already parsed UUID
+func (o ParseOptions) check(u ValidatedUUID) error {
+	if len(o.Versions) > 0 && !slices.Contains(o.Versions, u.Version()) {
+		return fmt.Errorf("UUID version %d is not allowed, want one of %v", u.Version(), o.Versions)
+	}
+	if o.RFC4122Only && !u.IsRFC4122() {
+		return fmt.Errorf("UUID variant %s is not allowed, want %s", u.UUID.Variant(), uuid.RFC4122)
+	}
+	if slices.Contains(o.Reject, u) {
+		return fmt.Errorf("UUID %s is a known test vector", u.UUID)
+	}
+	return nil
+}
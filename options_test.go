@@ -0,0 +1,147 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWithOptions(t *testing.T) {
+	v4 := "550e8400-e29b-41d4-a716-446655440000"
+	v7 := "01890a5d-ac96-774b-bcce-b302099a8057"
+	microsoft := "550e8400-e29b-41d4-c716-446655440000"
+
+	tests := []struct {
+		name    string
+		input   string
+		opts    ParseOptions
+		wantErr bool
+	}{
+		{name: "no policy", input: v4},
+		{name: "allowed version", input: v7, opts: ParseOptions{Versions: []int{7}}},
+		{name: "one of several versions", input: v4, opts: ParseOptions{Versions: []int{4, 7}}},
+		{name: "disallowed version", input: v4, opts: ParseOptions{Versions: []int{7}}, wantErr: true},
+		{name: "RFC 4122 variant", input: v4, opts: ParseOptions{RFC4122Only: true}},
+		{name: "non RFC 4122 variant", input: microsoft, opts: ParseOptions{RFC4122Only: true}, wantErr: true},
+		{name: "invalid input", input: "not-a-uuid", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseWithOptions(tt.input, tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.True(t, result.IsZero())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.input, result.String())
+			}
+		})
+	}
+}
+
+func TestParseWith(t *testing.T) {
+	v4 := "550e8400-e29b-41d4-a716-446655440000"
+	nilUUID := "00000000-0000-0000-0000-000000000000"
+
+	tests := []struct {
+		name    string
+		input   string
+		opts    []ParseOption
+		wantErr error // checked with errors.Is
+		reject  bool  // any error, for policy failures without a sentinel
+	}{
+		{name: "defaults", input: v4},
+		{name: "nil rejected by default", input: nilUUID, wantErr: ErrNil},
+		{name: "nil allowed", input: nilUUID, opts: []ParseOption{AllowNil()}},
+		{name: "nil allowed despite version", input: nilUUID, opts: []ParseOption{AllowNil(), RequireVersion(4)}},
+		{name: "required version", input: v4, opts: []ParseOption{RequireVersion(4)}},
+		{name: "wrong version", input: v4, opts: []ParseOption{RequireVersion(7)}, reject: true},
+		{name: "one of versions", input: v4, opts: []ParseOption{RequireVersion(7), RequireVersion(4)}},
+		{name: "uppercase accepted by default", input: "550E8400-E29B-41D4-A716-446655440000"},
+		{name: "uppercase rejected", input: "550E8400-E29B-41D4-A716-446655440000", opts: []ParseOption{CaseSensitive()}, wantErr: ErrInvalidFormat},
+		{name: "uppercase URN prefix rejected", input: "URN:UUID:" + v4, opts: []ParseOption{CaseSensitive()}, wantErr: ErrInvalidFormat},
+		{name: "lowercase accepted", input: v4, opts: []ParseOption{CaseSensitive()}},
+		{name: "URN accepted by default", input: "urn:uuid:" + v4},
+		{name: "URN rejected", input: "urn:uuid:" + v4, opts: []ParseOption{DisallowURNForm()}, wantErr: ErrInvalidFormat},
+		{name: "uppercase URN rejected", input: "URN:UUID:" + v4, opts: []ParseOption{DisallowURNForm()}, wantErr: ErrInvalidFormat},
+		{name: "braced accepted without URN", input: "{" + v4 + "}", opts: []ParseOption{DisallowURNForm()}},
+		{name: "invalid input", input: "not-a-uuid", opts: []ParseOption{AllowNil()}, wantErr: ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseWith(tt.input, tt.opts...)
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.True(t, result.IsZero())
+			case tt.reject:
+				assert.Error(t, err)
+				assert.True(t, result.IsZero())
+			default:
+				require.NoError(t, err)
+				want, _ := uuid.Parse(tt.input)
+				assert.Equal(t, want, result.UUID)
+			}
+		})
+	}
+}
+
+func TestFromProtoPolicy(t *testing.T) {
+	v7Only := ParseOptions{Versions: []int{7}}
+
+	t.Run("v4 rejected under v7-only policy", func(t *testing.T) {
+		_, err := FromProtoPolicy(&UUID{Val: "550e8400-e29b-41d4-a716-446655440000"}, v7Only)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "version 4 is not allowed")
+	})
+
+	t.Run("v7 accepted under v7-only policy", func(t *testing.T) {
+		u, err := FromProtoPolicy(&UUID{Val: "01890a5d-ac96-774b-bcce-b302099a8057"}, v7Only)
+		require.NoError(t, err)
+		assert.Equal(t, 7, int(u.UUID.Version()))
+	})
+
+	t.Run("nil fails", func(t *testing.T) {
+		_, err := FromProtoPolicy(nil, v7Only)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be nil")
+	})
+}
+
+func TestWithRejectKnownTestVectors(t *testing.T) {
+	example := "550e8400-e29b-41d4-a716-446655440000"
+
+	t.Run("accepted by default", func(t *testing.T) {
+		_, err := ParseWith(example)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejected under the option", func(t *testing.T) {
+		_, err := ParseWith(example, WithRejectKnownTestVectors())
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "known test vector")
+
+		_, err = ParseWith("6BA7B810-9DAD-11D1-80B4-00C04FD430C8", WithRejectKnownTestVectors())
+		assert.Error(t, err)
+	})
+
+	t.Run("other UUIDs accepted under the option", func(t *testing.T) {
+		u := New()
+		parsed, err := ParseWith(u.String(), WithRejectKnownTestVectors())
+		require.NoError(t, err)
+		assert.Equal(t, u, parsed)
+	})
+
+	t.Run("custom list", func(t *testing.T) {
+		custom := New()
+		_, err := ParseWith(custom.String(), WithRejectKnownTestVectors(custom))
+		assert.Error(t, err)
+
+		_, err = ParseWith(example, WithRejectKnownTestVectors(custom))
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,155 @@
+package uuid
+
+import "github.com/google/uuid"
+
+// hexValues and lowerHexValues map ASCII bytes to their hex value, or 0xff for
+// bytes that are not (lowercase) hex digits
+var (
+	hexValues      = hexTable(true)
+	lowerHexValues = hexTable(false)
+)
+
+func hexTable(upper bool) [256]byte {
+	var t [256]byte
+	for i := range t {
+		t[i] = 0xff
+	}
+	for c := byte('0'); c <= '9'; c++ {
+		t[c] = c - '0'
+	}
+	for c := byte('a'); c <= 'f'; c++ {
+		t[c] = c - 'a' + 10
+		if upper {
+			t[c-'a'+'A'] = c - 'a' + 10
+		}
+	}
+	return t
+}
+
+// text is the input accepted by the allocation-free decoders
+type text interface {
+	string | []byte
+}
+
+// decodeCanonical decodes the 36-character hyphenated form without allocating.
+// When lowerOnly is set, uppercase hex digits are rejected.
+func decodeCanonical[T text](s T, lowerOnly bool) (uuid.UUID, bool) {
+	var u uuid.UUID
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, false
+	}
+
+	t := &hexValues
+	if lowerOnly {
+		t = &lowerHexValues
+	}
+
+	// Invalid digits map to 0xff, so any high bit set in bad marks a failure.
+	// Indexes are constants so the compiler can drop bounds checks.
+	var bad byte
+	pair := func(hi, lo byte) byte {
+		h, l := t[hi], t[lo]
+		bad |= h | l
+		return h<<4 | l
+	}
+	u[0], u[1], u[2], u[3] = pair(s[0], s[1]), pair(s[2], s[3]), pair(s[4], s[5]), pair(s[6], s[7])
+	u[4], u[5] = pair(s[9], s[10]), pair(s[11], s[12])
+	u[6], u[7] = pair(s[14], s[15]), pair(s[16], s[17])
+	u[8], u[9] = pair(s[19], s[20]), pair(s[21], s[22])
+	u[10], u[11], u[12], u[13], u[14], u[15] = pair(s[24], s[25]), pair(s[26], s[27]), pair(s[28], s[29]), pair(s[30], s[31]), pair(s[32], s[33]), pair(s[34], s[35])
+	return u, bad&0xf0 == 0
+}
+
+// urnPrefix is the lowercase prefix of the URN form
+const urnPrefix = "urn:uuid:"
+
+// decodeText decodes every textual form uuid.Parse accepts (canonical, URN,
+// braced and 32 hex digits) without allocating
+func decodeText[T text](s T) (uuid.UUID, bool) {
+	switch len(s) {
+	case 36:
+		return decodeCanonical(s, false)
+	case 36 + len(urnPrefix):
+		for i := 0; i < len(urnPrefix); i++ {
+			c := s[i]
+			if 'A' <= c && c <= 'Z' {
+				c += 'a' - 'A'
+			}
+			if c != urnPrefix[i] {
+				return uuid.UUID{}, false
+			}
+		}
+		return decodeCanonical(s[len(urnPrefix):], false)
+	case 36 + 2:
+		// Like uuid.Parse, the surrounding characters are not inspected
+		return decodeCanonical(s[1:37], false)
+	case 32:
+		var u uuid.UUID
+		var bad byte
+		for i := range u {
+			h, l := hexValues[s[i*2]], hexValues[s[i*2+1]]
+			bad |= h | l
+			u[i] = h<<4 | l
+		}
+		return u, bad&0xf0 == 0
+	default:
+		return uuid.UUID{}, false
+	}
+}
+
+// TryParse parses s like Parse but reports failure as false instead of an
+// error, so rejecting untrusted input does not allocate
+func TryParse(s string) (ValidatedUUID, bool) {
+	u, ok := decodeText(s)
+	if !ok || u == uuid.Nil {
+		return ValidatedUUID{}, false
+	}
+	return ValidatedUUID{UUID: u}, true
+}
+
+// IsValid reports whether Parse would accept s, without allocating
+func IsValid(s string) bool {
+	u, ok := decodeText(s)
+	return ok && u != uuid.Nil
+}
+
+// ParseStrict accepts only the canonical 36-character lowercase hyphenated
+// form, rejecting braces, URNs, uppercase and unhyphenated input. It decodes
+// bytes directly and is faster than Parse for hot paths.
+func ParseStrict(s string) (ValidatedUUID, error) {
+	if s == "" {
+		return ValidatedUUID{}, ErrEmpty
+	}
+
+	u, ok := decodeCanonical(s, true)
+	if !ok {
+		return ValidatedUUID{}, invalidFormatf("not canonical lowercase hyphenated form")
+	}
+	if u == uuid.Nil {
+		return ValidatedUUID{}, ErrNil
+	}
+	return ValidatedUUID{UUID: u}, nil
+}
+
+// ParseBytes parses b like Parse without converting it to a string first. It
+// accepts every textual form Parse does as well as the raw 16-byte form.
+func ParseBytes(b []byte) (ValidatedUUID, error) {
+	if len(b) == 0 {
+		return ValidatedUUID{}, ErrEmpty
+	}
+
+	var u uuid.UUID
+	if len(b) == 16 {
+		u = uuid.UUID(b)
+	} else if decoded, ok := decodeText(b); ok {
+		u = decoded
+	} else {
+		// Only the failure path pays for the conversion, to report why
+		return Parse(string(b))
+	}
+
+	if u == uuid.Nil {
+		return ValidatedUUID{}, ErrNil
+	}
+	return ValidatedUUID{UUID: u}, nil
+}
@@ -0,0 +1,157 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStrict(t *testing.T) {
+	canonical := "550e8400-e29b-41d4-a716-446655440000"
+
+	t.Run("canonical accepted", func(t *testing.T) {
+		u, err := ParseStrict(canonical)
+		require.NoError(t, err)
+		assert.Equal(t, MustParse(canonical), u)
+
+		for i := 0; i < 100; i++ {
+			want := New()
+			got, err := ParseStrict(want.String())
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		}
+	})
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty", input: ""},
+		{name: "nil", input: "00000000-0000-0000-0000-000000000000"},
+		{name: "uppercase", input: "550E8400-E29B-41D4-A716-446655440000"},
+		{name: "mixed case", input: "550e8400-e29b-41d4-A716-446655440000"},
+		{name: "braced", input: "{550e8400-e29b-41d4-a716-446655440000}"},
+		{name: "urn", input: "urn:uuid:550e8400-e29b-41d4-a716-446655440000"},
+		{name: "simple", input: "550e8400e29b41d4a716446655440000"},
+		{name: "misplaced hyphen", input: "550e840-0e29b-41d4-a716-446655440000"},
+		{name: "non-hex", input: "550e8400-e29b-41d4-a716-44665544000g"},
+		{name: "too long", input: canonical + "0"},
+		{name: "too short", input: canonical[:35]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" rejected", func(t *testing.T) {
+			u, err := ParseStrict(tt.input)
+			assert.Error(t, err)
+			assert.True(t, u.IsZero())
+		})
+	}
+}
+
+// parseInputs covers every accepted form plus a spread of malformed input
+var parseInputs = []string{
+	"550e8400-e29b-41d4-a716-446655440000",
+	"550E8400-E29B-41D4-A716-446655440000",
+	"urn:uuid:550e8400-e29b-41d4-a716-446655440000",
+	"URN:UUID:550e8400-e29b-41d4-a716-446655440000",
+	"{550e8400-e29b-41d4-a716-446655440000}",
+	"550e8400e29b41d4a716446655440000",
+	"00000000-0000-0000-0000-000000000000",
+	"00000000000000000000000000000000",
+	"",
+	"invalid",
+	"550e8400-e29b-41d4-a716-44665544000g",
+	"550e8400-e29b-41d4-a716_446655440000",
+	"urn:uuix:550e8400-e29b-41d4-a716-446655440000",
+	"550e8400e29b41d4a71644665544000z",
+	"550e8400-e29b-41d4-a716-4466554400000",
+}
+
+func TestTryParse(t *testing.T) {
+	t.Run("agrees with Parse", func(t *testing.T) {
+		for _, s := range parseInputs {
+			want, err := Parse(s)
+			got, ok := TryParse(s)
+			assert.Equal(t, err == nil, ok, "input %q", s)
+			assert.Equal(t, want, got, "input %q", s)
+		}
+	})
+
+	t.Run("rejection does not allocate", func(t *testing.T) {
+		allocs := testing.AllocsPerRun(100, func() {
+			TryParse("550e8400-e29b-41d4-a716-44665544000g")
+			TryParse("invalid")
+		})
+		assert.Zero(t, allocs)
+	})
+}
+
+func TestIsValid(t *testing.T) {
+	t.Run("agrees with Parse", func(t *testing.T) {
+		for _, s := range parseInputs {
+			_, err := Parse(s)
+			assert.Equal(t, err == nil, IsValid(s), "input %q", s)
+		}
+	})
+
+	t.Run("does not allocate", func(t *testing.T) {
+		allocs := testing.AllocsPerRun(100, func() {
+			IsValid("550e8400-e29b-41d4-a716-446655440000")
+			IsValid("invalid")
+			IsValid("00000000-0000-0000-0000-000000000000")
+		})
+		assert.Zero(t, allocs)
+	})
+}
+
+func BenchmarkIsValid(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		IsValid("550e8400-e29b-41d4-a716-446655440000")
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	t.Run("agrees with Parse for text", func(t *testing.T) {
+		for _, s := range parseInputs {
+			want, wantErr := Parse(s)
+			got, err := ParseBytes([]byte(s))
+			assert.Equal(t, wantErr, err, "input %q", s)
+			assert.Equal(t, want, got, "input %q", s)
+		}
+	})
+
+	t.Run("raw 16 bytes", func(t *testing.T) {
+		want := MustParse("550e8400-e29b-41d4-a716-446655440000")
+		got, err := ParseBytes(want.UUID[:])
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+
+		_, err = ParseBytes(make([]byte, 16))
+		assert.Error(t, err)
+	})
+
+	t.Run("success does not allocate", func(t *testing.T) {
+		b := []byte("550e8400-e29b-41d4-a716-446655440000")
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = ParseBytes(b)
+		})
+		assert.Zero(t, allocs)
+	})
+}
+
+func BenchmarkParseStrict(b *testing.B) {
+	s := New().String()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseStrict(s)
+	}
+}
+
+func BenchmarkGoogleParse(b *testing.B) {
+	s := New().String()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = uuid.Parse(s)
+	}
+}
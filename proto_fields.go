@@ -0,0 +1,121 @@
+package uuid
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// isUUIDMessage reports whether md is the protobuf UUID message
+func isUUIDMessage(md protoreflect.MessageDescriptor) bool {
+	return md.FullName() == (*UUID)(nil).ProtoReflect().Descriptor().FullName()
+}
+
+// uuidFieldVisitor is called for every UUID-typed field found by walkUUIDFields.
+// msg is nil when a singular UUID field is unset.
+type uuidFieldVisitor func(path string, msg protoreflect.Message)
+
+// walkUUIDFields visits every UUID-typed field reachable from m, recursing into
+// populated nested messages. Repeated and map fields report their elements
+// under the field's own path, since field paths cannot address elements.
+func walkUUIDFields(m protoreflect.Message, prefix string, visit uuidFieldVisitor) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := string(fd.Name())
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+
+		switch {
+		case fd.IsMap():
+			valueFD := fd.MapValue()
+			if valueFD.Message() == nil {
+				continue
+			}
+			m.Get(fd).Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				walkUUIDValue(valueFD.Message(), path, v.Message(), visit)
+				return true
+			})
+		case fd.Message() == nil:
+			continue
+		case fd.IsList():
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				walkUUIDValue(fd.Message(), path, list.Get(j).Message(), visit)
+			}
+		case !m.Has(fd):
+			// unchosen oneof alternatives are not missing values
+			if od := fd.ContainingOneof(); od != nil && m.WhichOneof(od) != fd {
+				continue
+			}
+			if isUUIDMessage(fd.Message()) {
+				visit(path, nil)
+			}
+		default:
+			walkUUIDValue(fd.Message(), path, m.Get(fd).Message(), visit)
+		}
+	}
+}
+
+// walkUUIDValue visits msg if it is a UUID, or walks into it otherwise
+func walkUUIDValue(md protoreflect.MessageDescriptor, path string, msg protoreflect.Message, visit uuidFieldVisitor) {
+	if isUUIDMessage(md) {
+		visit(path, msg)
+		return
+	}
+	walkUUIDFields(msg, path, visit)
+}
+
+// validateUUIDMessage validates a reflected UUID message, which may be a
+// generated *UUID or a dynamic message sharing its descriptor
+func validateUUIDMessage(msg protoreflect.Message) error {
+	if msg == nil || !msg.IsValid() {
+		return ErrNilProto
+	}
+	val := msg.Get(msg.Descriptor().Fields().ByName("val")).String()
+	_, err := Parse(val)
+	return err
+}
+
+// InvalidUUIDPaths walks every UUID field of m, including nested, repeated and
+// map fields, and returns a FieldMask of the paths whose values are unset or
+// invalid. The mask is empty when every UUID field is valid.
+func InvalidUUIDPaths(m proto.Message) (*fieldmaskpb.FieldMask, error) {
+	if m == nil {
+		return nil, fmt.Errorf("message cannot be nil")
+	}
+
+	mask := &fieldmaskpb.FieldMask{}
+	seen := make(map[string]bool)
+	walkUUIDFields(m.ProtoReflect(), "", func(path string, msg protoreflect.Message) {
+		if seen[path] || validateUUIDMessage(msg) == nil {
+			return
+		}
+		seen[path] = true
+		mask.Paths = append(mask.Paths, path)
+	})
+	return mask, nil
+}
+
+// UUIDFieldErrors walks m like InvalidUUIDPaths but checks only UUID fields
+// that are set, returning a ValidationError naming the path of each invalid
+// one. It returns nil when every set UUID field is valid.
+func UUIDFieldErrors(m proto.Message) []*ValidationError {
+	if m == nil {
+		return nil
+	}
+
+	var errs []*ValidationError
+	walkUUIDFields(m.ProtoReflect(), "", func(path string, msg protoreflect.Message) {
+		if msg == nil {
+			return
+		}
+		if err := validateUUIDMessage(msg); err != nil {
+			errs = append(errs, &ValidationError{Op: "protobuf validation", Field: path, Err: err})
+		}
+	})
+	return errs
+}
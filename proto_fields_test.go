@@ -0,0 +1,209 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// testRequestDescriptor builds a message with several UUID fields:
+//
+//	message Inner { alexheld.uuid.v1.UUID id = 1; }
+//	message Request {
+//	  alexheld.uuid.v1.UUID owner_id = 1;
+//	  alexheld.uuid.v1.UUID parent_id = 2;
+//	  repeated alexheld.uuid.v1.UUID member_ids = 3;
+//	  Inner inner = 4;
+//	  string name = 5;
+//	}
+func testRequestDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	t.Helper()
+
+	uuidField := func(name string, number int32, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    label.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(".alexheld.uuid.v1.UUID"),
+		}
+	}
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	oneofField := func(fd *descriptorpb.FieldDescriptorProto) *descriptorpb.FieldDescriptorProto {
+		fd.OneofIndex = proto.Int32(0)
+		return fd
+	}
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("proto_fields_test.proto"),
+		Package:    proto.String("alexheld.uuid.test"),
+		Dependency: []string{"uuid.proto"},
+		Syntax:     proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name:  proto.String("Inner"),
+				Field: []*descriptorpb.FieldDescriptorProto{uuidField("id", 1, optional)},
+			},
+			{
+				Name: proto.String("Request"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					uuidField("owner_id", 1, optional),
+					uuidField("parent_id", 2, optional),
+					uuidField("member_ids", 3, descriptorpb.FieldDescriptorProto_LABEL_REPEATED),
+					{
+						Name:     proto.String("inner"),
+						Number:   proto.Int32(4),
+						Label:    optional.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+						TypeName: proto.String(".alexheld.uuid.test.Inner"),
+					},
+					{
+						Name:   proto.String("name"),
+						Number: proto.Int32(5),
+						Label:  optional.Enum(),
+						Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					},
+					oneofField(uuidField("user_id", 6, optional)),
+					oneofField(uuidField("group_id", 7, optional)),
+				},
+				OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("target")}},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	return file.Messages().ByName("Request")
+}
+
+// setUUIDField stores val in the UUID message held by field name of msg
+func setUUIDField(msg protoreflect.Message, name, val string) {
+	fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+	sub := msg.Mutable(fd).Message()
+	sub.Set(sub.Descriptor().Fields().ByName("val"), protoreflect.ValueOfString(val))
+}
+
+// appendUUIDElement appends a UUID holding val to the repeated field name of msg
+func appendUUIDElement(msg protoreflect.Message, name, val string) {
+	fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+	list := msg.Mutable(fd).List()
+	elem := list.NewElement()
+	elem.Message().Set(elem.Message().Descriptor().Fields().ByName("val"), protoreflect.ValueOfString(val))
+	list.Append(elem)
+}
+
+func TestInvalidUUIDPaths(t *testing.T) {
+	md := testRequestDescriptor(t)
+	valid := New().String()
+
+	t.Run("one valid and one invalid field", func(t *testing.T) {
+		msg := dynamicpb.NewMessage(md)
+		setUUIDField(msg, "owner_id", valid)
+		setUUIDField(msg, "parent_id", "not-a-uuid")
+		setUUIDField(msg.Mutable(md.Fields().ByName("inner")).Message(), "id", valid)
+
+		mask, err := InvalidUUIDPaths(msg)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"parent_id"}, mask.GetPaths())
+	})
+
+	t.Run("all valid", func(t *testing.T) {
+		msg := dynamicpb.NewMessage(md)
+		setUUIDField(msg, "owner_id", valid)
+		setUUIDField(msg, "parent_id", valid)
+		appendUUIDElement(msg, "member_ids", valid)
+
+		mask, err := InvalidUUIDPaths(msg)
+		require.NoError(t, err)
+		assert.Empty(t, mask.GetPaths())
+	})
+
+	t.Run("unset, nested and repeated fields", func(t *testing.T) {
+		msg := dynamicpb.NewMessage(md)
+		setUUIDField(msg, "owner_id", valid)
+		appendUUIDElement(msg, "member_ids", valid)
+		appendUUIDElement(msg, "member_ids", "bad")
+		appendUUIDElement(msg, "member_ids", "")
+		setUUIDField(msg.Mutable(md.Fields().ByName("inner")).Message(), "id", "00000000-0000-0000-0000-000000000000")
+
+		mask, err := InvalidUUIDPaths(msg)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"parent_id", "member_ids", "inner.id"}, mask.GetPaths())
+	})
+
+	t.Run("only the chosen oneof member is checked", func(t *testing.T) {
+		msg := dynamicpb.NewMessage(md)
+		setUUIDField(msg, "owner_id", valid)
+		setUUIDField(msg, "parent_id", valid)
+		setUUIDField(msg, "user_id", valid)
+
+		mask, err := InvalidUUIDPaths(msg)
+		require.NoError(t, err)
+		assert.Empty(t, mask.GetPaths())
+
+		setUUIDField(msg, "group_id", "bad")
+		mask, err = InvalidUUIDPaths(msg)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"group_id"}, mask.GetPaths())
+	})
+
+	t.Run("generated UUID message", func(t *testing.T) {
+		mask, err := InvalidUUIDPaths(&UUID{Val: valid})
+		require.NoError(t, err)
+		assert.Empty(t, mask.GetPaths())
+	})
+
+	t.Run("nil message fails", func(t *testing.T) {
+		_, err := InvalidUUIDPaths(nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestUUIDFieldErrors(t *testing.T) {
+	md := testRequestDescriptor(t)
+	valid := New().String()
+
+	t.Run("skips unset fields", func(t *testing.T) {
+		msg := dynamicpb.NewMessage(md)
+		setUUIDField(msg, "owner_id", valid)
+		assert.Empty(t, UUIDFieldErrors(msg))
+	})
+
+	t.Run("reports invalid set fields by path", func(t *testing.T) {
+		msg := dynamicpb.NewMessage(md)
+		setUUIDField(msg, "owner_id", "nope")
+		appendUUIDElement(msg, "member_ids", valid)
+		appendUUIDElement(msg, "member_ids", "")
+		setUUIDField(msg.Mutable(md.Fields().ByName("inner")).Message(), "id", "00000000-0000-0000-0000-000000000000")
+
+		errs := UUIDFieldErrors(msg)
+		require.Len(t, errs, 3)
+		assert.Equal(t, "owner_id", errs[0].Field)
+		assert.ErrorIs(t, errs[0], ErrInvalidFormat)
+		assert.Equal(t, "member_ids", errs[1].Field)
+		assert.ErrorIs(t, errs[1], ErrEmpty)
+		assert.Equal(t, "inner.id", errs[2].Field)
+		assert.ErrorIs(t, errs[2], ErrNil)
+	})
+
+	t.Run("only the chosen oneof member is checked", func(t *testing.T) {
+		msg := dynamicpb.NewMessage(md)
+		setUUIDField(msg, "user_id", "bad")
+
+		errs := UUIDFieldErrors(msg)
+		require.Len(t, errs, 1)
+		assert.Equal(t, "user_id", errs[0].Field)
+
+		setUUIDField(msg, "group_id", valid)
+		assert.Empty(t, UUIDFieldErrors(msg))
+	})
+
+	t.Run("nil message", func(t *testing.T) {
+		assert.Nil(t, UUIDFieldErrors(nil))
+	})
+}
@@ -0,0 +1,76 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// RandomBits returns the random-bearing bits of a v4 or v7 UUID packed
+// most-significant-bit first, with the final byte zero-padded. For v4 these
+// are the 122 bits outside the version and variant fields (16 bytes); for v7
+// only the 62-bit rand_b field is returned (8 bytes), since rand_a may carry a
+// sub-millisecond counter. Other versions are rejected.
+func (u ValidatedUUID) RandomBits() ([]byte, error) {
+	if err := u.Validate(); err != nil {
+		return nil, err
+	}
+
+	var random func(bit int) bool
+	switch u.Version() {
+	case 4:
+		random = func(bit int) bool {
+			return (bit < 48 || bit > 51) && bit != 64 && bit != 65
+		}
+	case 7:
+		random = func(bit int) bool {
+			return bit >= 66
+		}
+	default:
+		return nil, fmt.Errorf("UUID version %d has no random bits", u.Version())
+	}
+
+	var out []byte
+	n := 0
+	for bit := 0; bit < 128; bit++ {
+		if !random(bit) {
+			continue
+		}
+		if n%8 == 0 {
+			out = append(out, 0)
+		}
+		if u.UUID[bit/8]&(0x80>>(bit%8)) != 0 {
+			out[n/8] |= 0x80 >> (n % 8)
+		}
+		n++
+	}
+	return out, nil
+}
+
+// ChildWithPrefix returns a new UUID sharing its first prefixBytes bytes with
+// u and randomizing the rest, while keeping u's version and variant bits so
+// children sort next to their parent. prefixBytes must be between 1 and 15.
+func (u ValidatedUUID) ChildWithPrefix(prefixBytes int) (ValidatedUUID, error) {
+	if err := u.Validate(); err != nil {
+		return ValidatedUUID{}, err
+	}
+	if prefixBytes < 1 || prefixBytes > 15 {
+		return ValidatedUUID{}, fmt.Errorf("prefix length must be between 1 and 15 bytes, got %d", prefixBytes)
+	}
+
+	child := u
+	if _, err := rand.Read(child.UUID[prefixBytes:]); err != nil {
+		return ValidatedUUID{}, fmt.Errorf("failed to read random bytes: %w", err)
+	}
+
+	child.UUID[6] = u.UUID[6]&0xf0 | child.UUID[6]&0x0f
+	variantMask := byte(0xc0)
+	if u.UUID[8]&0xc0 != 0x80 {
+		variantMask = 0xe0
+	}
+	child.UUID[8] = u.UUID[8]&variantMask | child.UUID[8]&^variantMask
+	for i := 0; i < prefixBytes; i++ {
+		child.UUID[i] = u.UUID[i]
+	}
+
+	return FromGoogleUUID(child.UUID)
+}
@@ -0,0 +1,87 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatedUUID_RandomBits(t *testing.T) {
+	t.Run("v4 excludes version and variant", func(t *testing.T) {
+		a, err := New().RandomBits()
+		require.NoError(t, err)
+		b, err := New().RandomBits()
+		require.NoError(t, err)
+
+		assert.Len(t, a, 16)
+		assert.Len(t, b, 16)
+		assert.NotEqual(t, a, b)
+		// 122 bits leave 6 padding bits in the final byte
+		assert.Zero(t, a[15]&0x3f)
+		assert.Zero(t, b[15]&0x3f)
+	})
+
+	t.Run("v4 bits are packed in order", func(t *testing.T) {
+		u := MustParse("ffffffff-ffff-4fff-bfff-ffffffffffff")
+		bits, err := u.RandomBits()
+		require.NoError(t, err)
+		for i := 0; i < 15; i++ {
+			assert.Equal(t, byte(0xff), bits[i])
+		}
+		assert.Equal(t, byte(0xc0), bits[15])
+	})
+
+	t.Run("v7 returns rand_b", func(t *testing.T) {
+		u := MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+		bits, err := u.RandomBits()
+		require.NoError(t, err)
+		// rand_b is 0x3cceb302099a8057 shifted left by the two variant bits
+		assert.Equal(t, []byte{0xf3, 0x3a, 0xcc, 0x08, 0x26, 0x6a, 0x01, 0x5c}, bits)
+	})
+
+	t.Run("deterministic versions fail", func(t *testing.T) {
+		v5 := MustFromGoogleUUID(uuid.NewSHA1(uuid.NameSpaceDNS, []byte("example.com")))
+		_, err := v5.RandomBits()
+		assert.Error(t, err)
+	})
+
+	t.Run("zero UUID fails", func(t *testing.T) {
+		_, err := ValidatedUUID{}.RandomBits()
+		assert.Error(t, err)
+	})
+}
+
+func TestValidatedUUID_ChildWithPrefix(t *testing.T) {
+	parent := New()
+
+	t.Run("prefix matches and children differ", func(t *testing.T) {
+		for _, n := range []int{1, 4, 6, 8, 15} {
+			a, err := parent.ChildWithPrefix(n)
+			require.NoError(t, err)
+			b, err := parent.ChildWithPrefix(n)
+			require.NoError(t, err)
+
+			assert.Equal(t, parent.UUID[:n], a.UUID[:n])
+			assert.Equal(t, parent.UUID[:n], b.UUID[:n])
+			assert.Equal(t, parent.UUID.Version(), a.UUID.Version())
+			assert.Equal(t, parent.UUID.Variant(), a.UUID.Variant())
+			if n < 15 {
+				assert.NotEqual(t, a, b)
+			}
+		}
+	})
+
+	t.Run("invalid prefix length fails", func(t *testing.T) {
+		for _, n := range []int{-1, 0, 16} {
+			_, err := parent.ChildWithPrefix(n)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("zero parent fails", func(t *testing.T) {
+		_, err := ValidatedUUID{}.ChildWithPrefix(4)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,62 @@
+package uuid
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Check runs each rule against u in order and returns the first failure
+func (u ValidatedUUID) Check(rules ...func(ValidatedUUID) error) error {
+	for _, rule := range rules {
+		if err := rule(u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RuleVersion requires the UUID to be of version v
+func RuleVersion(v int) func(ValidatedUUID) error {
+	return func(u ValidatedUUID) error {
+		if u.Version() != v {
+			return fmt.Errorf("UUID version %d does not match required version %d", u.Version(), v)
+		}
+		return nil
+	}
+}
+
+// RuleRFC4122 requires the UUID to use the RFC 4122 variant
+func RuleRFC4122() func(ValidatedUUID) error {
+	return func(u ValidatedUUID) error {
+		if !u.IsRFC4122() {
+			return fmt.Errorf("UUID variant %s is not %s", u.UUID.Variant(), uuid.RFC4122)
+		}
+		return nil
+	}
+}
+
+// RuleNotMax rejects the max UUID ffffffff-ffff-ffff-ffff-ffffffffffff
+func RuleNotMax() func(ValidatedUUID) error {
+	return func(u ValidatedUUID) error {
+		if u.UUID == uuid.Max {
+			return fmt.Errorf("UUID cannot be the max value")
+		}
+		return nil
+	}
+}
+
+// RuleTimeRange requires a time-based UUID whose timestamp lies within [a, b]
+func RuleTimeRange(a, b time.Time) func(ValidatedUUID) error {
+	return func(u ValidatedUUID) error {
+		ts, err := u.Time()
+		if err != nil {
+			return err
+		}
+		if ts.Before(a) || ts.After(b) {
+			return fmt.Errorf("UUID timestamp %s is outside [%s, %s]", ts.Format(time.RFC3339Nano), a.Format(time.RFC3339Nano), b.Format(time.RFC3339Nano))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,55 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatedUUID_Check(t *testing.T) {
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	v7 := v7At(t, base)
+
+	t.Run("no rules", func(t *testing.T) {
+		assert.NoError(t, New().Check())
+	})
+
+	t.Run("all rules pass", func(t *testing.T) {
+		err := v7.Check(
+			RuleVersion(7),
+			RuleRFC4122(),
+			RuleNotMax(),
+			RuleTimeRange(base.Add(-time.Hour), base.Add(time.Hour)),
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("second rule fails", func(t *testing.T) {
+		calls := 0
+		counting := func(ValidatedUUID) error {
+			calls++
+			return nil
+		}
+
+		err := New().Check(counting, RuleVersion(7), counting)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "required version 7")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("RFC 4122 variant", func(t *testing.T) {
+		assert.Error(t, MustParse("550e8400-e29b-41d4-c716-446655440000").Check(RuleRFC4122()))
+	})
+
+	t.Run("max UUID", func(t *testing.T) {
+		assert.Error(t, MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff").Check(RuleNotMax()))
+	})
+
+	t.Run("time range", func(t *testing.T) {
+		assert.NoError(t, v7.Check(RuleTimeRange(base, base)))
+		assert.Error(t, v7.Check(RuleTimeRange(base.Add(time.Second), base.Add(time.Hour))))
+		assert.Error(t, v7.Check(RuleTimeRange(base.Add(-time.Hour), base.Add(-time.Second))))
+		assert.Error(t, New().Check(RuleTimeRange(base, base)))
+	})
+}
@@ -0,0 +1,123 @@
+package uuid
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// Set is an unordered collection of distinct ValidatedUUIDs. The zero value is
+// an empty set ready to use. It marshals to a JSON array sorted by byte order.
+// It is not safe for concurrent use.
+type Set struct {
+	m map[ValidatedUUID]struct{}
+}
+
+// NewSet creates a set holding ids
+func NewSet(ids ...ValidatedUUID) Set {
+	s := Set{m: make(map[ValidatedUUID]struct{}, len(ids))}
+	for _, u := range ids {
+		s.m[u] = struct{}{}
+	}
+	return s
+}
+
+// Add adds u to the set, reporting whether it was not already present
+func (s *Set) Add(u ValidatedUUID) bool {
+	if _, ok := s.m[u]; ok {
+		return false
+	}
+	if s.m == nil {
+		s.m = make(map[ValidatedUUID]struct{})
+	}
+	s.m[u] = struct{}{}
+	return true
+}
+
+// Remove removes u from the set, reporting whether it was present
+func (s *Set) Remove(u ValidatedUUID) bool {
+	if _, ok := s.m[u]; !ok {
+		return false
+	}
+	delete(s.m, u)
+	return true
+}
+
+// Contains reports whether u is in the set
+func (s Set) Contains(u ValidatedUUID) bool {
+	_, ok := s.m[u]
+	return ok
+}
+
+// Len returns the number of UUIDs in the set
+func (s Set) Len() int {
+	return len(s.m)
+}
+
+// Union returns a new set holding the UUIDs in s or other
+func (s Set) Union(other Set) Set {
+	out := Set{m: make(map[ValidatedUUID]struct{}, len(s.m)+len(other.m))}
+	for u := range s.m {
+		out.m[u] = struct{}{}
+	}
+	for u := range other.m {
+		out.m[u] = struct{}{}
+	}
+	return out
+}
+
+// Intersect returns a new set holding the UUIDs in both s and other
+func (s Set) Intersect(other Set) Set {
+	small, large := s, other
+	if len(small.m) > len(large.m) {
+		small, large = large, small
+	}
+
+	out := Set{m: make(map[ValidatedUUID]struct{})}
+	for u := range small.m {
+		if large.Contains(u) {
+			out.m[u] = struct{}{}
+		}
+	}
+	return out
+}
+
+// Difference returns a new set holding the UUIDs in s but not in other
+func (s Set) Difference(other Set) Set {
+	out := Set{m: make(map[ValidatedUUID]struct{})}
+	for u := range s.m {
+		if !other.Contains(u) {
+			out.m[u] = struct{}{}
+		}
+	}
+	return out
+}
+
+// Slice returns the UUIDs in the set sorted by byte order, or nil if it is empty
+func (s Set) Slice() []ValidatedUUID {
+	if len(s.m) == 0 {
+		return nil
+	}
+
+	ids := make([]ValidatedUUID, 0, len(s.m))
+	for u := range s.m {
+		ids = append(ids, u)
+	}
+	slices.SortFunc(ids, ValidatedUUID.Compare)
+	return ids
+}
+
+// MarshalJSON implements json.Marshaler, writing a sorted JSON array
+func (s Set) MarshalJSON() ([]byte, error) {
+	return UUIDs(s.Slice()).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the contents of s with
+// the UUIDs of a JSON array. Duplicates are collapsed.
+func (s *Set) UnmarshalJSON(data []byte) error {
+	var ids []ValidatedUUID
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+	*s = NewSet(ids...)
+	return nil
+}
@@ -0,0 +1,58 @@
+package uuid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSet(t *testing.T) {
+	a := MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+	b := MustParse("550e8400-e29b-41d4-a716-446655440000")
+	c := MustParse("f81d4fae-7dec-11d0-a765-00a0c91e6bf6")
+
+	t.Run("add, remove and contains", func(t *testing.T) {
+		var s Set
+		assert.False(t, s.Contains(a))
+		assert.True(t, s.Add(a))
+		assert.False(t, s.Add(a))
+		assert.True(t, s.Contains(a))
+		assert.Equal(t, 1, s.Len())
+
+		assert.True(t, s.Remove(a))
+		assert.False(t, s.Remove(a))
+		assert.Zero(t, s.Len())
+	})
+
+	t.Run("set algebra", func(t *testing.T) {
+		x := NewSet(a, b)
+		y := NewSet(b, c)
+
+		assert.Equal(t, []ValidatedUUID{a, b, c}, x.Union(y).Slice())
+		assert.Equal(t, []ValidatedUUID{b}, x.Intersect(y).Slice())
+		assert.Equal(t, []ValidatedUUID{a}, x.Difference(y).Slice())
+		assert.Nil(t, x.Difference(x).Slice())
+
+		// Operands are left untouched
+		assert.Equal(t, []ValidatedUUID{a, b}, x.Slice())
+		assert.Equal(t, []ValidatedUUID{b, c}, y.Slice())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(NewSet(c, a, b))
+		require.NoError(t, err)
+		assert.JSONEq(t, `["01890a5d-ac96-774b-bcce-b302099a8057","550e8400-e29b-41d4-a716-446655440000","f81d4fae-7dec-11d0-a765-00a0c91e6bf6"]`, string(data))
+
+		var got Set
+		require.NoError(t, json.Unmarshal([]byte(`["550e8400-e29b-41d4-a716-446655440000","550e8400-e29b-41d4-a716-446655440000"]`), &got))
+		assert.Equal(t, []ValidatedUUID{b}, got.Slice())
+
+		data, err = json.Marshal(Set{})
+		require.NoError(t, err)
+		assert.Equal(t, "[]", string(data))
+
+		assert.Error(t, json.Unmarshal([]byte(`["invalid"]`), &got))
+	})
+}
@@ -0,0 +1,128 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Dialect identifies the SQL flavour used when rendering UUIDs into queries
+type Dialect int
+
+const (
+	// DialectPostgres renders PostgreSQL syntax
+	DialectPostgres Dialect = iota
+	// DialectMySQL renders MySQL syntax
+	DialectMySQL
+)
+
+// String returns the name of the dialect
+func (d Dialect) String() string {
+	switch d {
+	case DialectPostgres:
+		return "postgres"
+	case DialectMySQL:
+		return "mysql"
+	default:
+		return fmt.Sprintf("Dialect(%d)", int(d))
+	}
+}
+
+// SQLLiteral returns the UUID as a PostgreSQL literal, e.g. '<uuid>'::uuid
+func (u ValidatedUUID) SQLLiteral() (string, error) {
+	return u.SQLLiteralFor(DialectPostgres)
+}
+
+// SQLLiteralFor returns the UUID as a quoted literal for the given dialect
+func (u ValidatedUUID) SQLLiteralFor(d Dialect) (string, error) {
+	if err := u.Validate(); err != nil {
+		return "", validationFailed("SQL rendering", err)
+	}
+
+	switch d {
+	case DialectPostgres:
+		return "'" + u.UUID.String() + "'::uuid", nil
+	case DialectMySQL:
+		return "'" + u.UUID.String() + "'", nil
+	default:
+		return "", fmt.Errorf("unsupported SQL dialect: %s", d)
+	}
+}
+
+// DBRoundTrip passes the UUID through Value and then Scan, returning the
+// scanned result so tests can assert the database contract in one call
+func (u ValidatedUUID) DBRoundTrip() (ValidatedUUID, error) {
+	value, err := u.Value()
+	if err != nil {
+		return ValidatedUUID{}, err
+	}
+
+	var scanned ValidatedUUID
+	if err := scanned.Scan(value); err != nil {
+		return ValidatedUUID{}, err
+	}
+	return scanned, nil
+}
+
+// BinaryUUID is a ValidatedUUID stored as its raw 16 bytes, for MySQL
+// BINARY(16) columns and drivers such as pgx that accept bytes for Postgres
+// uuid columns. Scanning and all other behavior is that of the embedded
+// ValidatedUUID.
+type BinaryUUID struct {
+	ValidatedUUID
+}
+
+// Value implements driver.Valuer, writing the 16-byte form
+func (u BinaryUUID) Value() (driver.Value, error) {
+	if err := u.Validate(); err != nil {
+		return nil, validationFailed("database write", err)
+	}
+	b := u.UUID
+	return b[:], nil
+}
+
+// InClause builds a PostgreSQL IN list such as ($1,$2,$3) for ids, numbering
+// placeholders from startIndex, together with the matching query args
+func InClause(startIndex int, ids []ValidatedUUID) (clause string, args []driver.Value, err error) {
+	return InClauseFor(DialectPostgres, startIndex, ids)
+}
+
+// InClauseFor builds an IN list for the given dialect. PostgreSQL uses
+// numbered placeholders starting at startIndex; MySQL uses ? and ignores
+// startIndex. Args are produced by each UUID's Value method.
+func InClauseFor(d Dialect, startIndex int, ids []ValidatedUUID) (clause string, args []driver.Value, err error) {
+	if len(ids) == 0 {
+		return "", nil, fmt.Errorf("IN clause requires at least one UUID")
+	}
+	if d == DialectPostgres && startIndex < 1 {
+		return "", nil, fmt.Errorf("placeholder index must be at least 1, got %d", startIndex)
+	}
+	if d != DialectPostgres && d != DialectMySQL {
+		return "", nil, fmt.Errorf("unsupported SQL dialect: %s", d)
+	}
+
+	var b strings.Builder
+	args = make([]driver.Value, len(ids))
+	b.WriteByte('(')
+	for i, u := range ids {
+		value, err := u.Value()
+		if err != nil {
+			return "", nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		args[i] = value
+
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if d == DialectPostgres {
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(startIndex + i))
+		} else {
+			b.WriteByte('?')
+		}
+	}
+	b.WriteByte(')')
+
+	return b.String(), args, nil
+}
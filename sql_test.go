@@ -0,0 +1,171 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatedUUID_SQLLiteral(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("postgres", func(t *testing.T) {
+		literal, err := u.SQLLiteral()
+		require.NoError(t, err)
+		assert.Equal(t, "'550e8400-e29b-41d4-a716-446655440000'::uuid", literal)
+	})
+
+	t.Run("mysql", func(t *testing.T) {
+		literal, err := u.SQLLiteralFor(DialectMySQL)
+		require.NoError(t, err)
+		assert.Equal(t, "'550e8400-e29b-41d4-a716-446655440000'", literal)
+	})
+
+	t.Run("unknown dialect fails", func(t *testing.T) {
+		_, err := u.SQLLiteralFor(Dialect(42))
+		assert.Error(t, err)
+	})
+
+	t.Run("zero UUID fails", func(t *testing.T) {
+		_, err := ValidatedUUID{}.SQLLiteral()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "validation failed")
+	})
+}
+
+func TestValidatedUUID_DBRoundTrip(t *testing.T) {
+	t.Run("valid UUID", func(t *testing.T) {
+		u := New()
+		scanned, err := u.DBRoundTrip()
+		require.NoError(t, err)
+		assert.Equal(t, u, scanned)
+	})
+
+	t.Run("zero UUID fails at Value", func(t *testing.T) {
+		_, err := ValidatedUUID{}.DBRoundTrip()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "database write")
+	})
+}
+
+type scanStringer string
+
+func (s scanStringer) String() string { return string(s) }
+
+func TestValidatedUUID_Scan(t *testing.T) {
+	want := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "string", value: "550e8400-e29b-41d4-a716-446655440000"},
+		{name: "text bytes", value: []byte("550e8400-e29b-41d4-a716-446655440000")},
+		{name: "binary bytes", value: want.UUID[:]},
+		{name: "uuid.UUID", value: want.UUID},
+		{name: "array", value: [16]byte(want.UUID)},
+		{name: "stringer", value: scanStringer("550E8400-E29B-41D4-A716-446655440000")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ValidatedUUID
+			require.NoError(t, got.Scan(tt.value))
+			assert.Equal(t, want, got)
+		})
+	}
+
+	t.Run("invalid values fail", func(t *testing.T) {
+		var got ValidatedUUID
+		assert.Error(t, got.Scan(nil))
+		assert.Error(t, got.Scan(make([]byte, 16)))
+		assert.Error(t, got.Scan(uuid.Nil))
+		assert.Error(t, got.Scan([16]byte{}))
+		assert.Error(t, got.Scan(make([]byte, 15)))
+		assert.Error(t, got.Scan(42))
+		assert.Error(t, got.Scan(scanStringer("nope")))
+	})
+}
+
+func TestBinaryUUID(t *testing.T) {
+	u := BinaryUUID{MustParse("550e8400-e29b-41d4-a716-446655440000")}
+
+	t.Run("value is 16 bytes", func(t *testing.T) {
+		v, err := u.Value()
+		require.NoError(t, err)
+		assert.Equal(t, u.UUID[:], v)
+	})
+
+	t.Run("scan round trip", func(t *testing.T) {
+		v, err := u.Value()
+		require.NoError(t, err)
+
+		var got BinaryUUID
+		require.NoError(t, got.Scan(v))
+		assert.Equal(t, u, got)
+	})
+
+	t.Run("scan text", func(t *testing.T) {
+		var got BinaryUUID
+		require.NoError(t, got.Scan("550e8400-e29b-41d4-a716-446655440000"))
+		assert.Equal(t, u, got)
+	})
+
+	t.Run("invalid fails", func(t *testing.T) {
+		_, err := BinaryUUID{}.Value()
+		assert.Error(t, err)
+
+		var got BinaryUUID
+		assert.Error(t, got.Scan(make([]byte, 16)))
+		assert.Error(t, got.Scan(nil))
+	})
+
+	t.Run("keeps JSON behavior", func(t *testing.T) {
+		data, err := u.MarshalJSON()
+		require.NoError(t, err)
+		assert.Equal(t, `"550e8400-e29b-41d4-a716-446655440000"`, string(data))
+	})
+}
+
+func TestInClause(t *testing.T) {
+	a := New()
+	b := New()
+	c := New()
+
+	t.Run("postgres", func(t *testing.T) {
+		clause, args, err := InClause(3, []ValidatedUUID{a, b, c})
+		require.NoError(t, err)
+		assert.Equal(t, "($3,$4,$5)", clause)
+		assert.Equal(t, []driver.Value{a.String(), b.String(), c.String()}, args)
+	})
+
+	t.Run("mysql", func(t *testing.T) {
+		clause, args, err := InClauseFor(DialectMySQL, 0, []ValidatedUUID{a, b})
+		require.NoError(t, err)
+		assert.Equal(t, "(?,?)", clause)
+		assert.Equal(t, []driver.Value{a.String(), b.String()}, args)
+	})
+
+	t.Run("empty slice fails", func(t *testing.T) {
+		_, _, err := InClause(1, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("zero UUID fails with index", func(t *testing.T) {
+		_, _, err := InClause(1, []ValidatedUUID{a, {}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "index 1")
+	})
+
+	t.Run("invalid start index fails", func(t *testing.T) {
+		_, _, err := InClause(0, []ValidatedUUID{a})
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown dialect fails", func(t *testing.T) {
+		_, _, err := InClauseFor(Dialect(42), 1, []ValidatedUUID{a})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,259 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// isTimeBased reports whether the UUID version embeds a timestamp
+func (u ValidatedUUID) isTimeBased() bool {
+	switch u.Version() {
+	case 1, 6, 7:
+		return true
+	default:
+		return false
+	}
+}
+
+// Time returns the creation time embedded in a v1, v6 or v7 UUID, in UTC.
+// Other versions fail with ErrNotTimeBased.
+func (u ValidatedUUID) Time() (time.Time, error) {
+	if err := u.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	if !u.isTimeBased() {
+		return time.Time{}, fmt.Errorf("%w: version %d", ErrNotTimeBased, u.Version())
+	}
+	t := u.UUID.Time()
+	if u.Version() == 6 {
+		// google/uuid does not decode the RFC 9562 v6 layout, so reassemble
+		// the 60-bit timestamp from its 48 high and 12 low bits
+		hi := binary.BigEndian.Uint64(u.UUID[:8])
+		t = uuid.Time(hi>>16<<12 | hi&0x0fff)
+	}
+	sec, nsec := t.UnixTime()
+	return time.Unix(sec, nsec).UTC(), nil
+}
+
+// ClockWentBackwards reports whether next carries an earlier timestamp than prev.
+// Both UUIDs must be of the same time-based version (v1, v6 or v7).
+func ClockWentBackwards(prev, next ValidatedUUID) (bool, error) {
+	if prev.Version() != next.Version() {
+		return false, fmt.Errorf("UUID versions differ: %d and %d", prev.Version(), next.Version())
+	}
+
+	prevTime, err := prev.Time()
+	if err != nil {
+		return false, fmt.Errorf("invalid previous UUID: %w", err)
+	}
+	nextTime, err := next.Time()
+	if err != nil {
+		return false, fmt.Errorf("invalid next UUID: %w", err)
+	}
+
+	return nextTime.Before(prevTime), nil
+}
+
+// NextAfter returns a v7 UUID strictly greater than u, for advancing
+// pagination cursors. If the clock has moved past u's millisecond a fresh v7
+// is generated; otherwise the random bits of u are incremented by one, which
+// keeps cursors moving forward under clock skew. The timestamp, version and
+// variant are kept. It fails for non-v7 input and once the random bits of u's
+// millisecond are exhausted.
+func NextAfter(u ValidatedUUID) (ValidatedUUID, error) {
+	if u.Version() != 7 {
+		return ValidatedUUID{}, fmt.Errorf("UUID version %d is not 7", u.Version())
+	}
+	ts, err := u.Time()
+	if err != nil {
+		return ValidatedUUID{}, err
+	}
+
+	if time.Now().UnixMilli() > ts.UnixMilli() {
+		next, err := uuid.NewV7()
+		if err != nil {
+			return ValidatedUUID{}, fmt.Errorf("failed to generate UUID: %w", err)
+		}
+		return FromGoogleUUID(next)
+	}
+
+	// rand_a is the 12 bits below the version nibble and rand_b the 62 bits
+	// below the variant; increment rand_b, carrying into rand_a
+	const (
+		randAMask = 0x0fff
+		randBMask = 1<<62 - 1
+	)
+	hi := binary.BigEndian.Uint16(u.UUID[6:])
+	lo := binary.BigEndian.Uint64(u.UUID[8:])
+	switch {
+	case lo&randBMask != randBMask:
+		lo++
+	case hi&randAMask != randAMask:
+		hi++
+		lo &^= randBMask
+	default:
+		return ValidatedUUID{}, fmt.Errorf("cannot advance past the last UUID of its millisecond")
+	}
+
+	next := u
+	binary.BigEndian.PutUint16(next.UUID[6:], hi)
+	binary.BigEndian.PutUint64(next.UUID[8:], lo)
+	return next, nil
+}
+
+// ExpiresAt returns the UUID's embedded creation time plus ttl, for caches
+// that derive expiry from time-based keys. Random and name-based versions fail.
+func (u ValidatedUUID) ExpiresAt(ttl time.Duration) (time.Time, error) {
+	ts, err := u.Time()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return ts.Add(ttl), nil
+}
+
+// IsExpired reports whether now is at or past ExpiresAt(ttl)
+func (u ValidatedUUID) IsExpired(ttl time.Duration, now time.Time) (bool, error) {
+	expiry, err := u.ExpiresAt(ttl)
+	if err != nil {
+		return false, err
+	}
+	return !now.Before(expiry), nil
+}
+
+// maxClockSequence is the largest value that fits the 14-bit clock sequence
+const maxClockSequence = 0x3fff
+
+// timeBasedOptions holds overrides for v1 and v6 generation
+type timeBasedOptions struct {
+	node     *[6]byte
+	clockSeq *uint16
+}
+
+// TimeBasedOption configures NewV1 and NewV6
+type TimeBasedOption func(*timeBasedOptions)
+
+// WithNodeID sets the 48-bit node field instead of the process-wide node ID
+func WithNodeID(node [6]byte) TimeBasedOption {
+	return func(o *timeBasedOptions) {
+		o.node = &node
+	}
+}
+
+// WithClockSequence sets the 14-bit clock sequence instead of the
+// process-wide one. Values above 0x3fff are rejected. UUIDs generated with
+// the same node and clock sequence stay unique: when the clock does not
+// advance between calls the timestamp is moved one 100ns tick forward.
+func WithClockSequence(seq uint16) TimeBasedOption {
+	return func(o *timeBasedOptions) {
+		o.clockSeq = &seq
+	}
+}
+
+// NewV1 creates a v1 UUID from the current time, optionally overriding the
+// node ID and clock sequence
+func NewV1(opts ...TimeBasedOption) (ValidatedUUID, error) {
+	return newTimeBased(v1Layout, opts)
+}
+
+// NewV6 creates a v6 UUID from the current time, optionally overriding the
+// node ID and clock sequence
+func NewV6(opts ...TimeBasedOption) (ValidatedUUID, error) {
+	return newTimeBased(v6Layout, opts)
+}
+
+// getTime returns the current 100ns timestamp and google/uuid's clock
+// sequence, which it bumps when the clock does not advance
+var getTime = uuid.GetTime
+
+// newV6 builds a v6 UUID from the process-wide node ID and clock sequence
+func newV6() (uuid.UUID, error) {
+	now, seq, err := getTime()
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	return v6Layout(uint64(now), seq, uuid.NodeID()), nil
+}
+
+// v1Layout lays out a v1 UUID: the timestamp low, mid and high fields, the
+// version, then the clock sequence and node
+func v1Layout(ts uint64, seq uint16, node []byte) uuid.UUID {
+	var u uuid.UUID
+	binary.BigEndian.PutUint32(u[0:], uint32(ts))
+	binary.BigEndian.PutUint16(u[4:], uint16(ts>>32))
+	binary.BigEndian.PutUint16(u[6:], 0x1000|uint16(ts>>48)&0x0fff)
+	binary.BigEndian.PutUint16(u[8:], 0x8000|seq&maxClockSequence)
+	copy(u[10:], node)
+	return u
+}
+
+// v6Layout lays out a v6 UUID with the RFC 9562 layout: the 48 high timestamp
+// bits, the version, then the 12 low bits. google/uuid's NewV6 instead stores
+// the timestamp as one big-endian integer, which other implementations misread.
+func v6Layout(ts uint64, seq uint16, node []byte) uuid.UUID {
+	var u uuid.UUID
+	binary.BigEndian.PutUint64(u[0:], ts>>12<<16|0x6000|ts&0x0fff)
+	binary.BigEndian.PutUint16(u[8:], 0x8000|seq&maxClockSequence)
+	copy(u[10:], node)
+	return u
+}
+
+// fixedSeqKey identifies a node and caller-chosen clock sequence pair
+type fixedSeqKey struct {
+	node [6]byte
+	seq  uint16
+}
+
+// fixedSeqClock remembers the last timestamp issued for each node and fixed
+// clock sequence. google/uuid keeps timestamps unique by bumping its own clock
+// sequence, which a fixed sequence overrides, so the timestamp is advanced
+// instead. Entries are never evicted; callers are expected to use few pairs.
+var fixedSeqClock = struct {
+	sync.Mutex
+	last map[fixedSeqKey]uint64
+}{last: make(map[fixedSeqKey]uint64)}
+
+// nextFixedSeqTime returns ts, or one tick past the last timestamp issued for
+// key when ts does not move forward
+func nextFixedSeqTime(key fixedSeqKey, ts uint64) uint64 {
+	fixedSeqClock.Lock()
+	defer fixedSeqClock.Unlock()
+
+	if last, ok := fixedSeqClock.last[key]; ok && ts <= last {
+		ts = last + 1
+	}
+	fixedSeqClock.last[key] = ts
+	return ts
+}
+
+// newTimeBased generates a UUID with the node and clock sequence overrides,
+// leaving the package-wide state of google/uuid untouched
+func newTimeBased(layout func(ts uint64, seq uint16, node []byte) uuid.UUID, opts []TimeBasedOption) (ValidatedUUID, error) {
+	var o timeBasedOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.clockSeq != nil && *o.clockSeq > maxClockSequence {
+		return ValidatedUUID{}, fmt.Errorf("clock sequence %#x exceeds 14 bits", *o.clockSeq)
+	}
+
+	now, seq, err := getTime()
+	if err != nil {
+		return ValidatedUUID{}, fmt.Errorf("failed to generate UUID: %w", err)
+	}
+	ts := uint64(now)
+
+	var node [6]byte
+	copy(node[:], uuid.NodeID())
+	if o.node != nil {
+		node = *o.node
+	}
+	if o.clockSeq != nil {
+		seq = *o.clockSeq
+		ts = nextFixedSeqTime(fixedSeqKey{node: node, seq: seq}, ts)
+	}
+	return FromGoogleUUID(layout(ts, seq, node[:]))
+}
@@ -0,0 +1,277 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// v7At builds a v7 UUID with the given timestamp and fixed random bits
+func v7At(t *testing.T, ts time.Time) ValidatedUUID {
+	t.Helper()
+	var u uuid.UUID
+	var ms [8]byte
+	binary.BigEndian.PutUint64(ms[:], uint64(ts.UnixMilli()))
+	copy(u[0:6], ms[2:8])
+	u[6] = 0x70
+	u[8] = 0x80
+	u[15] = 0x01
+	return MustFromGoogleUUID(u)
+}
+
+func TestValidatedUUID_Time(t *testing.T) {
+	t.Run("v1", func(t *testing.T) {
+		ts, err := MustParse("f81d4fae-7dec-11d0-a765-00a0c91e6bf6").Time()
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(1997, 2, 3, 17, 43, 12, 216875000, time.UTC), ts)
+	})
+
+	t.Run("v6 matches v1", func(t *testing.T) {
+		ts, err := MustParse("1d07decf-81d4-6fae-a765-00a0c91e6bf6").Time()
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(1997, 2, 3, 17, 43, 12, 216875000, time.UTC), ts)
+	})
+
+	t.Run("v7", func(t *testing.T) {
+		want := time.Date(2024, 5, 6, 7, 8, 9, 123000000, time.UTC)
+		ts, err := v7At(t, want).Time()
+		require.NoError(t, err)
+		assert.Equal(t, want, ts)
+	})
+
+	t.Run("other versions fail", func(t *testing.T) {
+		_, err := MustParse("550e8400-e29b-41d4-a716-446655440000").Time()
+		assert.ErrorIs(t, err, ErrNotTimeBased)
+		assert.Contains(t, err.Error(), "version 4")
+
+		_, err = ValidatedUUID{}.Time()
+		assert.ErrorIs(t, err, ErrNil)
+	})
+}
+
+func TestClockWentBackwards(t *testing.T) {
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("in order", func(t *testing.T) {
+		prev := v7At(t, base)
+		next := v7At(t, base.Add(time.Millisecond))
+		backwards, err := ClockWentBackwards(prev, next)
+		require.NoError(t, err)
+		assert.False(t, backwards)
+	})
+
+	t.Run("same timestamp", func(t *testing.T) {
+		backwards, err := ClockWentBackwards(v7At(t, base), v7At(t, base))
+		require.NoError(t, err)
+		assert.False(t, backwards)
+	})
+
+	t.Run("regressed", func(t *testing.T) {
+		prev := v7At(t, base)
+		next := v7At(t, base.Add(-time.Second))
+		backwards, err := ClockWentBackwards(prev, next)
+		require.NoError(t, err)
+		assert.True(t, backwards)
+	})
+
+	t.Run("mismatched versions fail", func(t *testing.T) {
+		_, err := ClockWentBackwards(v7At(t, base), New())
+		assert.Error(t, err)
+	})
+
+	t.Run("non time-based versions fail", func(t *testing.T) {
+		_, err := ClockWentBackwards(New(), New())
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "does not embed a timestamp")
+	})
+}
+
+func TestNextAfter(t *testing.T) {
+	t.Run("time has advanced", func(t *testing.T) {
+		past := v7At(t, time.Now().Add(-time.Hour))
+		next, err := NextAfter(past)
+		require.NoError(t, err)
+		assert.Equal(t, 1, bytes.Compare(next.UUID[:], past.UUID[:]))
+		assert.Equal(t, 7, int(next.UUID.Version()))
+		pastTs, err := past.Time()
+		require.NoError(t, err)
+		nextTs, err := next.Time()
+		require.NoError(t, err)
+		assert.True(t, nextTs.After(pastTs), "expected a fresh UUID, not an increment")
+		assert.NotEqual(t, past.UUID[:6], next.UUID[:6])
+	})
+
+	t.Run("same millisecond", func(t *testing.T) {
+		future := v7At(t, time.Now().Add(time.Hour))
+		next, err := NextAfter(future)
+		require.NoError(t, err)
+		assert.Equal(t, future.UUID[:15], next.UUID[:15])
+		assert.Equal(t, future.UUID[15]+1, next.UUID[15])
+	})
+
+	t.Run("carries within rand_b", func(t *testing.T) {
+		u := MustParse("ffffffff-ffff-7000-80ff-ffffffffffff")
+		next, err := NextAfter(u)
+		require.NoError(t, err)
+		assert.Equal(t, "ffffffff-ffff-7000-8100-000000000000", next.String())
+	})
+
+	t.Run("carries into rand_a keeping version and variant", func(t *testing.T) {
+		u := MustParse("ffffffff-ffff-7ffe-bfff-ffffffffffff")
+		next, err := NextAfter(u)
+		require.NoError(t, err)
+		assert.Equal(t, "ffffffff-ffff-7fff-8000-000000000000", next.String())
+		assert.Equal(t, 7, next.Version())
+		assert.Equal(t, uuid.RFC4122, next.UUID.Variant())
+		assert.Equal(t, 1, next.Compare(u))
+
+		again, err := NextAfter(next)
+		require.NoError(t, err, "the cursor must keep advancing")
+		assert.Equal(t, 1, again.Compare(next))
+	})
+
+	t.Run("exhausted millisecond fails", func(t *testing.T) {
+		_, err := NextAfter(MustParse("ffffffff-ffff-7fff-bfff-ffffffffffff"))
+		assert.Error(t, err)
+	})
+
+	t.Run("non-v7 fails", func(t *testing.T) {
+		_, err := NextAfter(New())
+		assert.Error(t, err)
+	})
+}
+
+func TestValidatedUUID_ExpiresAt(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+
+	t.Run("recent v7", func(t *testing.T) {
+		u := v7At(t, now.Add(-time.Minute))
+		expiry, err := u.ExpiresAt(time.Hour)
+		require.NoError(t, err)
+		assert.True(t, expiry.Equal(now.Add(59*time.Minute)))
+
+		expired, err := u.IsExpired(time.Hour, now)
+		require.NoError(t, err)
+		assert.False(t, expired)
+	})
+
+	t.Run("old v7", func(t *testing.T) {
+		u := v7At(t, now.Add(-2*time.Hour))
+		expired, err := u.IsExpired(time.Hour, now)
+		require.NoError(t, err)
+		assert.True(t, expired)
+	})
+
+	t.Run("expires exactly at the boundary", func(t *testing.T) {
+		u := v7At(t, now.Add(-time.Hour))
+		expired, err := u.IsExpired(time.Hour, now)
+		require.NoError(t, err)
+		assert.True(t, expired)
+	})
+
+	t.Run("random versions fail", func(t *testing.T) {
+		_, err := New().ExpiresAt(time.Hour)
+		assert.Error(t, err)
+
+		_, err = New().IsExpired(time.Hour, now)
+		assert.Error(t, err)
+	})
+}
+
+func TestNewV1(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		u, err := NewV1()
+		require.NoError(t, err)
+		assert.Equal(t, uuid.Version(1), u.UUID.Version())
+		assert.Equal(t, uuid.RFC4122, u.UUID.Variant())
+	})
+
+	t.Run("node and clock sequence", func(t *testing.T) {
+		node := [6]byte{0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+		before := time.Now().Add(-time.Second)
+		u, err := NewV1(WithNodeID(node), WithClockSequence(0x2765))
+		require.NoError(t, err)
+
+		assert.Equal(t, node[:], u.UUID.NodeID())
+		assert.Equal(t, 0x2765, u.UUID.ClockSequence())
+		assert.Equal(t, uuid.RFC4122, u.UUID.Variant())
+		ts, err := u.Time()
+		require.NoError(t, err)
+		assert.True(t, ts.After(before))
+	})
+
+	t.Run("rejects oversized clock sequence", func(t *testing.T) {
+		_, err := NewV1(WithClockSequence(0x4000))
+		assert.Error(t, err)
+	})
+}
+
+func TestNewTimeBased_FixedClockSequence(t *testing.T) {
+	// freeze the clock so every call lands in the same 100ns tick
+	frozen, _, err := uuid.GetTime()
+	require.NoError(t, err)
+	original := getTime
+	t.Cleanup(func() { getTime = original })
+	getTime = func() (uuid.Time, uint16, error) { return frozen, 0, nil }
+
+	for _, tt := range []struct {
+		name     string
+		generate func(...TimeBasedOption) (ValidatedUUID, error)
+		node     [6]byte
+	}{
+		{"v1", NewV1, [6]byte{0xfe, 1, 1, 1, 1, 1}},
+		{"v6", NewV6, [6]byte{0xfe, 6, 6, 6, 6, 6}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := NewSet()
+			var prev time.Time
+			for i := 0; i < 100; i++ {
+				u, err := tt.generate(WithNodeID(tt.node), WithClockSequence(0x123))
+				require.NoError(t, err)
+				assert.True(t, seen.Add(u), "duplicate UUID %s", u)
+				assert.Equal(t, 0x123, u.UUID.ClockSequence())
+
+				ts, err := u.Time()
+				require.NoError(t, err)
+				if i > 0 {
+					assert.False(t, ts.Before(prev), "timestamps must not go backwards")
+				}
+				prev = ts
+			}
+		})
+	}
+
+	t.Run("clock going backwards", func(t *testing.T) {
+		node := [6]byte{0xfe, 9, 9, 9, 9, 9}
+		first, err := NewV1(WithNodeID(node), WithClockSequence(1))
+		require.NoError(t, err)
+
+		getTime = func() (uuid.Time, uint16, error) { return frozen - 1000, 0, nil }
+		second, err := NewV1(WithNodeID(node), WithClockSequence(1))
+		require.NoError(t, err)
+		assert.NotEqual(t, first, second)
+	})
+}
+
+func TestNewV6(t *testing.T) {
+	node := [6]byte{1, 2, 3, 4, 5, 6}
+	u, err := NewV6(WithNodeID(node), WithClockSequence(7))
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Version(6), u.UUID.Version())
+	assert.Equal(t, node[:], u.UUID.NodeID())
+	assert.Equal(t, 7, u.UUID.ClockSequence())
+	ts, err := u.Time()
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), ts, time.Minute)
+
+	other, err := NewV6(WithNodeID(node))
+	require.NoError(t, err)
+	same, err := SameNode(u, other)
+	require.NoError(t, err)
+	assert.True(t, same)
+}
@@ -0,0 +1,79 @@
+package uuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// SignedToken returns base64url(uuid bytes) + "." + base64url(HMAC-SHA256)
+// so the UUID can be handed out and later verified with ParseSignedToken
+func (u ValidatedUUID) SignedToken(key []byte) (string, error) {
+	if err := u.Validate(); err != nil {
+		return "", validationFailed("token signing", err)
+	}
+	if len(key) == 0 {
+		return "", fmt.Errorf("signing key cannot be empty")
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString(u.UUID[:])
+	signature := base64.RawURLEncoding.EncodeToString(signUUID(u.UUID, key))
+	return payload + "." + signature, nil
+}
+
+// ParseSignedToken verifies a token produced by SignedToken and returns the
+// UUID it carries. The signature is checked in constant time before the
+// payload is trusted.
+func ParseSignedToken(token string, key []byte) (ValidatedUUID, error) {
+	if len(key) == 0 {
+		return ValidatedUUID{}, fmt.Errorf("signing key cannot be empty")
+	}
+
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return ValidatedUUID{}, fmt.Errorf("invalid token format: missing signature")
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return ValidatedUUID{}, fmt.Errorf("invalid token payload: %w", err)
+	}
+	u, err := uuid.FromBytes(raw)
+	if err != nil {
+		return ValidatedUUID{}, fmt.Errorf("invalid token payload: %w", err)
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return ValidatedUUID{}, fmt.Errorf("invalid token signature: %w", err)
+	}
+
+	if !hmac.Equal(mac, signUUID(u, key)) {
+		return ValidatedUUID{}, fmt.Errorf("token signature mismatch")
+	}
+	return FromGoogleUUID(u)
+}
+
+// signUUID computes the HMAC-SHA256 of the UUID bytes
+func signUUID(u uuid.UUID, key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(u[:])
+	return mac.Sum(nil)
+}
+
+// Fingerprint returns the first 8 bytes of the SHA-256 digest of the UUID
+// bytes as 16 lowercase hex characters, for tamper checks in config or audit files
+func (u ValidatedUUID) Fingerprint() string {
+	sum := sha256.Sum256(u.UUID[:])
+	return hex.EncodeToString(sum[:8])
+}
+
+// VerifyFingerprint reports whether fp matches the UUID's Fingerprint, ignoring case
+func (u ValidatedUUID) VerifyFingerprint(fp string) bool {
+	return subtle.ConstantTimeCompare([]byte(strings.ToLower(fp)), []byte(u.Fingerprint())) == 1
+}
@@ -0,0 +1,88 @@
+package uuid
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedToken(t *testing.T) {
+	key := []byte("super-secret-key")
+
+	t.Run("valid token", func(t *testing.T) {
+		u := New()
+		token, err := u.SignedToken(key)
+		require.NoError(t, err)
+
+		parsed, err := ParseSignedToken(token, key)
+		require.NoError(t, err)
+		assert.Equal(t, u, parsed)
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		token, err := New().SignedToken(key)
+		require.NoError(t, err)
+		_, signature, _ := strings.Cut(token, ".")
+
+		other := New()
+		forged := base64.RawURLEncoding.EncodeToString(other.UUID[:]) + "." + signature
+		_, err = ParseSignedToken(forged, key)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "signature mismatch")
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		token, err := New().SignedToken(key)
+		require.NoError(t, err)
+
+		_, err = ParseSignedToken(token, []byte("other-key"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "signature mismatch")
+	})
+
+	t.Run("malformed token", func(t *testing.T) {
+		_, err := ParseSignedToken("no-separator", key)
+		assert.Error(t, err)
+
+		_, err = ParseSignedToken("!!!.abc", key)
+		assert.Error(t, err)
+	})
+
+	t.Run("empty key fails", func(t *testing.T) {
+		_, err := New().SignedToken(nil)
+		assert.Error(t, err)
+
+		_, err = ParseSignedToken("a.b", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("zero UUID fails", func(t *testing.T) {
+		_, err := ValidatedUUID{}.SignedToken(key)
+		assert.Error(t, err)
+	})
+}
+
+func TestValidatedUUID_Fingerprint(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("stable", func(t *testing.T) {
+		fp := u.Fingerprint()
+		assert.Len(t, fp, 16)
+		assert.Equal(t, fp, MustParse(u.String()).Fingerprint())
+	})
+
+	t.Run("matching", func(t *testing.T) {
+		assert.True(t, u.VerifyFingerprint(u.Fingerprint()))
+		assert.True(t, u.VerifyFingerprint(strings.ToUpper(u.Fingerprint())))
+	})
+
+	t.Run("non-matching", func(t *testing.T) {
+		assert.False(t, u.VerifyFingerprint(New().Fingerprint()))
+		assert.False(t, u.VerifyFingerprint(u.Fingerprint()[:15]))
+		assert.False(t, u.VerifyFingerprint(""))
+		assert.NotEqual(t, u.Fingerprint(), New().Fingerprint())
+	})
+}
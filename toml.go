@@ -0,0 +1,30 @@
+package uuid
+
+import "strconv"
+
+// MarshalTOML implements the toml.Marshaler interface of BurntSushi/toml,
+// writing the canonical form as a TOML string with validation.
+// pelletier/go-toml uses MarshalText, which validates the same way.
+func (u ValidatedUUID) MarshalTOML() ([]byte, error) {
+	if err := u.Validate(); err != nil {
+		return nil, validationFailed("TOML marshalling", err)
+	}
+	return []byte(strconv.Quote(u.String())), nil
+}
+
+// UnmarshalTOML implements the toml.Unmarshaler interface of BurntSushi/toml
+// with validation. pelletier/go-toml uses UnmarshalText instead.
+func (u *ValidatedUUID) UnmarshalTOML(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return validationFailed("TOML unmarshalling", invalidFormatf("expected string, got %T", v))
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return validationFailed("TOML unmarshalling", err)
+	}
+
+	*u = parsed
+	return nil
+}
@@ -0,0 +1,78 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	pelletier "github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tomlConfig struct {
+	TenantID ValidatedUUID `toml:"tenant_id"`
+}
+
+const tomlDoc = "tenant_id = \"550e8400-e29b-41d4-a716-446655440000\"\n"
+
+func TestValidatedUUID_TOML(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("BurntSushi round trip", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, toml.NewEncoder(&buf).Encode(tomlConfig{TenantID: u}))
+		assert.Equal(t, tomlDoc, buf.String())
+
+		var cfg tomlConfig
+		_, err := toml.Decode(buf.String(), &cfg)
+		require.NoError(t, err)
+		assert.Equal(t, u, cfg.TenantID)
+	})
+
+	t.Run("pelletier round trip", func(t *testing.T) {
+		out, err := pelletier.Marshal(tomlConfig{TenantID: u})
+		require.NoError(t, err)
+		assert.Equal(t, "tenant_id = '550e8400-e29b-41d4-a716-446655440000'\n", string(out))
+
+		var cfg tomlConfig
+		require.NoError(t, pelletier.Unmarshal(out, &cfg))
+		assert.Equal(t, u, cfg.TenantID)
+	})
+
+	t.Run("marshal zero", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.ErrorIs(t, toml.NewEncoder(&buf).Encode(tomlConfig{}), ErrNil)
+
+		_, err := pelletier.Marshal(tomlConfig{})
+		assert.ErrorIs(t, err, ErrNil)
+	})
+
+	// both decoders flatten errors to text, so match on the sentinel messages
+	tests := []struct {
+		name string
+		doc  string
+		want error
+	}{
+		{"nil UUID", `tenant_id = "00000000-0000-0000-0000-000000000000"`, ErrNil},
+		{"invalid", `tenant_id = "invalid"`, ErrInvalidFormat},
+		{"empty", `tenant_id = ""`, ErrEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg tomlConfig
+			_, err := toml.Decode(tt.doc, &cfg)
+			assert.ErrorContains(t, err, tt.want.Error(), "BurntSushi")
+
+			err = pelletier.Unmarshal([]byte(tt.doc), &cfg)
+			assert.ErrorContains(t, err, tt.want.Error(), "pelletier")
+		})
+	}
+
+	t.Run("not a string", func(t *testing.T) {
+		var cfg tomlConfig
+		_, err := toml.Decode("tenant_id = 42", &cfg)
+		assert.ErrorContains(t, err, ErrInvalidFormat.Error())
+	})
+}
@@ -66,16 +66,65 @@ func (x *UUID) GetVal() string {
 	return ""
 }
 
+// UUIDBytes represents a universally unique identifier as its raw 16 bytes,
+// a compact alternative to UUID for high-volume messages
+type UUIDBytes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Val []byte `protobuf:"bytes,1,opt,name=val,proto3" json:"val,omitempty"`
+}
+
+func (x *UUIDBytes) Reset() {
+	*x = UUIDBytes{}
+	mi := &file_uuid_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UUIDBytes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UUIDBytes) ProtoMessage() {}
+
+func (x *UUIDBytes) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UUIDBytes.ProtoReflect.Descriptor instead.
+func (*UUIDBytes) Descriptor() ([]byte, []int) {
+	return file_uuid_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UUIDBytes) GetVal() []byte {
+	if x != nil {
+		return x.Val
+	}
+	return nil
+}
+
 var File_uuid_proto protoreflect.FileDescriptor
 
 var file_uuid_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x61, 0x6c,
 	0x65, 0x78, 0x68, 0x65, 0x6c, 0x64, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x22, 0x18,
 	0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x65, 0x78, 0x2d, 0x68, 0x65, 0x6c, 0x64,
-	0x2f, 0x75, 0x75, 0x69, 0x64, 0x3b, 0x75, 0x75, 0x69, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x1d, 0x0a, 0x09, 0x55, 0x55, 0x49, 0x44,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x65, 0x78, 0x2d, 0x68, 0x65, 0x6c, 0x64, 0x2f,
+	0x75, 0x75, 0x69, 0x64, 0x3b, 0x75, 0x75, 0x69, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -90,9 +139,10 @@ func file_uuid_proto_rawDescGZIP() []byte {
 	return file_uuid_proto_rawDescData
 }
 
-var file_uuid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_uuid_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_uuid_proto_goTypes = []any{
-	(*UUID)(nil), // 0: alexheld.uuid.v1.UUID
+	(*UUID)(nil),      // 0: alexheld.uuid.v1.UUID
+	(*UUIDBytes)(nil), // 1: alexheld.uuid.v1.UUIDBytes
 }
 var file_uuid_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -113,7 +163,7 @@ func file_uuid_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_uuid_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
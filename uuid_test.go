@@ -1,12 +1,18 @@
 package uuid
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestValidatedUUID_Parse(t *testing.T) {
@@ -52,6 +58,32 @@ func TestValidatedUUID_Parse(t *testing.T) {
 	}
 }
 
+func TestNewV7(t *testing.T) {
+	u := NewV7()
+	require.NoError(t, u.Validate())
+	assert.Equal(t, uuid.Version(7), u.UUID.Version())
+	assert.Equal(t, uuid.RFC4122, u.UUID.Variant())
+
+	t.Run("ordered", func(t *testing.T) {
+		next := NewV7()
+		assert.Less(t, u.String(), next.String())
+	})
+
+	t.Run("round trips through Parse", func(t *testing.T) {
+		parsed, err := Parse(u.String())
+		require.NoError(t, err)
+		assert.Equal(t, u, parsed)
+	})
+
+	t.Run("round trips through proto", func(t *testing.T) {
+		pb, err := u.ToProto()
+		require.NoError(t, err)
+		back, err := FromProto(pb)
+		require.NoError(t, err)
+		assert.Equal(t, u, back)
+	})
+}
+
 func TestValidatedUUID_FromGoogleUUID(t *testing.T) {
 	t.Run("valid UUID", func(t *testing.T) {
 		googleUUID := uuid.New()
@@ -95,6 +127,76 @@ func TestValidatedUUID_JSON(t *testing.T) {
 	})
 }
 
+func TestValidatedUUID_Text(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("round trip", func(t *testing.T) {
+		text, err := u.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", string(text))
+
+		var got ValidatedUUID
+		require.NoError(t, got.UnmarshalText(text))
+		assert.Equal(t, u, got)
+	})
+
+	t.Run("zero value fails", func(t *testing.T) {
+		_, err := ValidatedUUID{}.MarshalText()
+		assert.Error(t, err)
+
+		var got ValidatedUUID
+		assert.Error(t, got.UnmarshalText([]byte("00000000-0000-0000-0000-000000000000")))
+		assert.Error(t, got.UnmarshalText([]byte("")))
+		assert.Error(t, got.UnmarshalText([]byte("invalid")))
+	})
+
+	t.Run("JSON map keys", func(t *testing.T) {
+		data, err := json.Marshal(map[ValidatedUUID]int{u: 1})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"550e8400-e29b-41d4-a716-446655440000":1}`, string(data))
+
+		var got map[ValidatedUUID]int
+		require.NoError(t, json.Unmarshal(data, &got))
+		assert.Equal(t, 1, got[u])
+
+		assert.Error(t, json.Unmarshal([]byte(`{"invalid":1}`), &got))
+	})
+}
+
+func TestValidatedUUID_Binary(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("round trip", func(t *testing.T) {
+		data, err := u.MarshalBinary()
+		require.NoError(t, err)
+		assert.Len(t, data, 16)
+		assert.Equal(t, u.UUID[:], data)
+
+		var got ValidatedUUID
+		require.NoError(t, got.UnmarshalBinary(data))
+		assert.Equal(t, u, got)
+	})
+
+	t.Run("gob", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(u))
+
+		var got ValidatedUUID
+		require.NoError(t, gob.NewDecoder(&buf).Decode(&got))
+		assert.Equal(t, u, got)
+	})
+
+	t.Run("invalid fails", func(t *testing.T) {
+		_, err := ValidatedUUID{}.MarshalBinary()
+		assert.Error(t, err)
+
+		var got ValidatedUUID
+		assert.Error(t, got.UnmarshalBinary(make([]byte, 16)))
+		assert.Error(t, got.UnmarshalBinary(u.UUID[:15]))
+		assert.Error(t, got.UnmarshalBinary(nil))
+	})
+}
+
 func TestValidatedUUID_Proto(t *testing.T) {
 	t.Run("to proto valid UUID", func(t *testing.T) {
 		u := New()
@@ -134,6 +236,165 @@ func TestValidatedUUID_Proto(t *testing.T) {
 	})
 }
 
+func TestValidatedUUID_ProtoBytes(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("round trip", func(t *testing.T) {
+		pb, err := u.ToProtoBytes()
+		require.NoError(t, err)
+		assert.Equal(t, u.UUID[:], pb.GetVal())
+
+		got, err := FromProtoBytes(pb)
+		require.NoError(t, err)
+		assert.Equal(t, u, got)
+	})
+
+	t.Run("smaller than the string form", func(t *testing.T) {
+		pb, err := u.ToProtoBytes()
+		require.NoError(t, err)
+		assert.Less(t, proto.Size(pb), proto.Size(u.MustToProto()))
+	})
+
+	t.Run("invalid fails", func(t *testing.T) {
+		_, err := ValidatedUUID{}.ToProtoBytes()
+		assert.ErrorIs(t, err, ErrNil)
+
+		_, err = FromProtoBytes(nil)
+		assert.ErrorIs(t, err, ErrNilProto)
+		_, err = FromProtoBytes(&UUIDBytes{})
+		assert.ErrorIs(t, err, ErrInvalidFormat)
+		_, err = FromProtoBytes(&UUIDBytes{Val: make([]byte, 16)})
+		assert.ErrorIs(t, err, ErrNil)
+	})
+}
+
+func TestValidatedUUID_BytesValue(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	bv, err := u.ToBytesValue()
+	require.NoError(t, err)
+	assert.Equal(t, u.UUID[:], bv.GetValue())
+
+	got, err := FromBytesValue(bv)
+	require.NoError(t, err)
+	assert.Equal(t, u, got)
+
+	_, err = ValidatedUUID{}.ToBytesValue()
+	assert.ErrorIs(t, err, ErrNil)
+	_, err = FromBytesValue(nil)
+	assert.Error(t, err)
+	_, err = FromBytesValue(wrapperspb.Bytes([]byte("550e8400")))
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+	_, err = FromBytesValue(wrapperspb.Bytes(make([]byte, 16)))
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func TestValidatedUUID_StructValue(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("round trip through a Struct", func(t *testing.T) {
+		v, err := u.ToStructValue()
+		require.NoError(t, err)
+
+		st := &structpb.Struct{Fields: map[string]*structpb.Value{"owner": v}}
+		data, err := proto.Marshal(st)
+		require.NoError(t, err)
+
+		var decoded structpb.Struct
+		require.NoError(t, proto.Unmarshal(data, &decoded))
+		got, err := FromStructValue(decoded.GetFields()["owner"])
+		require.NoError(t, err)
+		assert.Equal(t, u, got)
+	})
+
+	t.Run("invalid fails", func(t *testing.T) {
+		_, err := ValidatedUUID{}.ToStructValue()
+		assert.ErrorIs(t, err, ErrNil)
+
+		_, err = FromStructValue(nil)
+		assert.Error(t, err)
+		_, err = FromStructValue(structpb.NewNumberValue(42))
+		assert.Error(t, err)
+		_, err = FromStructValue(structpb.NewNullValue())
+		assert.Error(t, err)
+		_, err = FromStructValue(structpb.NewStringValue("invalid"))
+		assert.ErrorIs(t, err, ErrInvalidFormat)
+	})
+}
+
+func TestValidatedUUID_Any(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("round trip", func(t *testing.T) {
+		a, err := u.ToAny()
+		require.NoError(t, err)
+		assert.Equal(t, "type.googleapis.com/alexheld.uuid.v1.UUID", a.GetTypeUrl())
+
+		got, err := FromAnyProto(a)
+		require.NoError(t, err)
+		assert.Equal(t, u, got)
+
+		got, err = FromAny(a)
+		require.NoError(t, err)
+		assert.Equal(t, u, got)
+	})
+
+	t.Run("UUIDBytes payload", func(t *testing.T) {
+		pb, err := u.ToProtoBytes()
+		require.NoError(t, err)
+		a, err := anypb.New(pb)
+		require.NoError(t, err)
+
+		got, err := FromAnyProto(a)
+		require.NoError(t, err)
+		assert.Equal(t, u, got)
+	})
+
+	t.Run("invalid fails", func(t *testing.T) {
+		_, err := ValidatedUUID{}.ToAny()
+		assert.ErrorIs(t, err, ErrNil)
+
+		_, err = FromAnyProto(nil)
+		assert.Error(t, err)
+
+		other, err := anypb.New(wrapperspb.String(u.String()))
+		require.NoError(t, err)
+		_, err = FromAnyProto(other)
+		assert.Error(t, err)
+
+		bad, err := anypb.New(&UUID{Val: "invalid"})
+		require.NoError(t, err)
+		_, err = FromAnyProto(bad)
+		assert.ErrorIs(t, err, ErrInvalidFormat)
+	})
+}
+
+func TestCanonicalizeStringValue(t *testing.T) {
+	t.Run("uppercase normalized", func(t *testing.T) {
+		sv := wrapperspb.String("550E8400-E29B-41D4-A716-446655440000")
+		require.NoError(t, CanonicalizeStringValue(sv))
+		assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", sv.GetValue())
+	})
+
+	t.Run("braced normalized", func(t *testing.T) {
+		sv := wrapperspb.String("{550e8400-e29b-41d4-a716-446655440000}")
+		require.NoError(t, CanonicalizeStringValue(sv))
+		assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", sv.GetValue())
+	})
+
+	t.Run("nil rejected", func(t *testing.T) {
+		err := CanonicalizeStringValue(nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be nil")
+	})
+
+	t.Run("invalid left unchanged", func(t *testing.T) {
+		sv := wrapperspb.String("not-a-uuid")
+		assert.Error(t, CanonicalizeStringValue(sv))
+		assert.Equal(t, "not-a-uuid", sv.GetValue())
+	})
+}
+
 func TestHelpers(t *testing.T) {
 	validUUIDStr := "550e8400-e29b-41d4-a716-446655440000"
 
@@ -177,3 +438,41 @@ func TestHelpers(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestRepeatedProtoHelpers(t *testing.T) {
+	ids := []ValidatedUUID{New(), New(), New()}
+
+	t.Run("round trip", func(t *testing.T) {
+		pbs, err := UUIDsToProtos(ids)
+		require.NoError(t, err)
+		require.Len(t, pbs, 3)
+
+		got, err := ProtosToUUIDs(pbs)
+		require.NoError(t, err)
+		assert.Equal(t, ids, got)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		pbs, err := UUIDsToProtos(nil)
+		require.NoError(t, err)
+		assert.Empty(t, pbs)
+
+		got, err := ProtosToUUIDs(nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("reports every invalid index", func(t *testing.T) {
+		_, err := ProtosToUUIDs([]*UUID{ids[0].MustToProto(), {Val: "invalid"}, nil})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "index 1")
+		assert.Contains(t, err.Error(), "index 2")
+		assert.NotContains(t, err.Error(), "index 0")
+		assert.ErrorIs(t, err, ErrNilProto)
+
+		_, err = UUIDsToProtos([]ValidatedUUID{{}, ids[1]})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "index 0")
+		assert.ErrorIs(t, err, ErrNil)
+	})
+}
@@ -0,0 +1,57 @@
+// Package uuidcel lets CEL-based validation, such as protovalidate custom
+// constraints, apply the same rules as uuid.Parse.
+//
+// To constrain a string field in a schema, use Expression as a protovalidate
+// custom constraint:
+//
+//	string owner_id = 1 [(buf.validate.field).cel = {
+//	  id: "uuid.valid"
+//	  message: "must be a valid, non-nil UUID"
+//	  expression: "<Expression>"
+//	}];
+//
+// Programs built with cel-go can instead install Library and call
+// isValidUUID(value), which is backed by uuid.IsValid directly.
+package uuidcel
+
+import (
+	"github.com/alex-held/uuid"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// ConstraintID and ConstraintMessage are suggested values for the id and
+// message of a protovalidate constraint using Expression
+const (
+	ConstraintID      = "uuid.valid"
+	ConstraintMessage = "must be a valid, non-nil UUID"
+)
+
+// Expression is a CEL expression over this (a string) that holds exactly when
+// uuid.Parse accepts the value: the canonical, URN, braced and 32 hex digit
+// forms in either case, excluding the nil UUID. Unlike uuid.Parse, which does
+// not inspect the characters wrapping the braced form, it requires real braces.
+const Expression = `this.matches('^(?:(?i:urn:uuid:)?` + canonical + `|\\{` + canonical + `\\}|[0-9a-fA-F]{32})$')` +
+	` && !this.matches('^(?:(?i:urn:uuid:)?\\{?0{8}-0{4}-0{4}-0{4}-0{12}\\}?|0{32})$')`
+
+// canonical matches the hyphenated 8-4-4-4-12 form
+const canonical = `[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`
+
+// FunctionName is the CEL function installed by Library
+const FunctionName = "isValidUUID"
+
+// Library returns a cel.EnvOption declaring isValidUUID(string) bool
+func Library() cel.EnvOption {
+	return cel.Function(FunctionName,
+		cel.Overload("isValidUUID_string", []*cel.Type{cel.StringType}, cel.BoolType,
+			cel.UnaryBinding(func(v ref.Val) ref.Val {
+				s, ok := v.(types.String)
+				if !ok {
+					return types.MaybeNoSuchOverloadErr(v)
+				}
+				return types.Bool(uuid.IsValid(string(s)))
+			}),
+		),
+	)
+}
@@ -0,0 +1,68 @@
+package uuidcel
+
+import (
+	"testing"
+
+	"github.com/alex-held/uuid"
+	"github.com/google/cel-go/cel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var inputs = []string{
+	"550e8400-e29b-41d4-a716-446655440000",
+	"550E8400-E29B-41D4-A716-446655440000",
+	"urn:uuid:550e8400-e29b-41d4-a716-446655440000",
+	"URN:UUID:550e8400-e29b-41d4-a716-446655440000",
+	"{550e8400-e29b-41d4-a716-446655440000}",
+	"550e8400e29b41d4a716446655440000",
+	"00000000-0000-0000-0000-000000000000",
+	"urn:uuid:00000000-0000-0000-0000-000000000000",
+	"{00000000-0000-0000-0000-000000000000}",
+	"00000000000000000000000000000000",
+	"",
+	"invalid",
+	"550e8400-e29b-41d4-a716-44665544000g",
+	"550e8400-e29b-41d4-a716_446655440000",
+	"urn:uuix:550e8400-e29b-41d4-a716-446655440000",
+	"550e8400-e29b-41d4-a716-4466554400000",
+	" 550e8400-e29b-41d4-a716-446655440000",
+}
+
+// eval compiles expr against a string variable named this and evaluates it
+func eval(t *testing.T, env *cel.Env, expr, this string) bool {
+	t.Helper()
+
+	ast, issues := env.Compile(expr)
+	require.NoError(t, issues.Err())
+	prg, err := env.Program(ast)
+	require.NoError(t, err)
+
+	out, _, err := prg.Eval(map[string]interface{}{"this": this})
+	require.NoError(t, err)
+	return out.Value().(bool)
+}
+
+func TestExpression(t *testing.T) {
+	env, err := cel.NewEnv(cel.Variable("this", cel.StringType))
+	require.NoError(t, err)
+
+	for _, s := range inputs {
+		assert.Equal(t, uuid.IsValid(s), eval(t, env, Expression, s), "input %q", s)
+	}
+
+	t.Run("requires real braces", func(t *testing.T) {
+		s := "(550e8400-e29b-41d4-a716-446655440000)"
+		assert.True(t, uuid.IsValid(s))
+		assert.False(t, eval(t, env, Expression, s))
+	})
+}
+
+func TestLibrary(t *testing.T) {
+	env, err := cel.NewEnv(cel.Variable("this", cel.StringType), Library())
+	require.NoError(t, err)
+
+	for _, s := range inputs {
+		assert.Equal(t, uuid.IsValid(s), eval(t, env, FunctionName+"(this)", s), "input %q", s)
+	}
+}
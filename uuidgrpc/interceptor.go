@@ -0,0 +1,78 @@
+// Package uuidgrpc provides gRPC server interceptors that validate the UUID
+// fields of incoming protobuf messages, and metadata helpers that carry
+// UUIDs across gRPC hops.
+package uuidgrpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/alex-held/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryServerInterceptor rejects requests whose set UUID fields are invalid
+// with codes.InvalidArgument before the handler runs. The status carries a
+// BadRequest detail with one field violation per invalid path.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validate(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor validates every message received on the stream like
+// UnaryServerInterceptor, failing RecvMsg with codes.InvalidArgument
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss})
+	}
+}
+
+// validatingStream validates messages as they are received
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+// RecvMsg receives the next message and validates its UUID fields
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validate(m)
+}
+
+// validate returns an InvalidArgument status for invalid UUID fields of m,
+// or nil when m is valid or not a protobuf message
+func validate(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	errs := uuid.UUIDFieldErrors(msg)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	paths := make([]string, len(errs))
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(errs))
+	for i, err := range errs {
+		paths[i] = err.Field
+		violations[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       err.Field,
+			Description: err.Err.Error(),
+		}
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid UUID field: "+strings.Join(paths, ", "))
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,142 @@
+package uuidgrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alex-held/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// requestDescriptor describes message { alexheld.uuid.v1.UUID user_id = 1; }
+func requestDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	t.Helper()
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("uuidgrpc_test.proto"),
+		Package:    proto.String("alexheld.uuid.grpctest"),
+		Dependency: []string{"uuid.proto"},
+		Syntax:     proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("GetUserRequest"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("user_id"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+				TypeName: proto.String(".alexheld.uuid.v1.UUID"),
+			}},
+		}},
+	}, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	return file.Messages().Get(0)
+}
+
+// newRequest builds a request holding val, or with user_id unset when val is empty
+func newRequest(md protoreflect.MessageDescriptor, val string) proto.Message {
+	msg := dynamicpb.NewMessage(md)
+	if val != "" {
+		fd := msg.Descriptor().Fields().ByName("user_id")
+		sub := msg.Mutable(fd).Message()
+		sub.Set(sub.Descriptor().Fields().ByName("val"), protoreflect.ValueOfString(val))
+	}
+	return msg
+}
+
+// assertInvalidArgument checks err is InvalidArgument naming user_id
+func assertInvalidArgument(t *testing.T, err error) {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Contains(t, st.Message(), "user_id")
+
+	require.Len(t, st.Details(), 1)
+	br, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, br.GetFieldViolations(), 1)
+	assert.Equal(t, "user_id", br.GetFieldViolations()[0].GetField())
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	md := requestDescriptor(t)
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Users/GetUser"}
+
+	var called bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return "ok", nil
+	}
+
+	t.Run("valid request reaches handler", func(t *testing.T) {
+		called = false
+		resp, err := interceptor(context.Background(), newRequest(md, uuid.New().String()), info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+		assert.True(t, called)
+	})
+
+	t.Run("unset field is allowed", func(t *testing.T) {
+		called = false
+		_, err := interceptor(context.Background(), newRequest(md, ""), info, handler)
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("invalid field is rejected", func(t *testing.T) {
+		called = false
+		_, err := interceptor(context.Background(), newRequest(md, "not-a-uuid"), info, handler)
+		assertInvalidArgument(t, err)
+		assert.False(t, called)
+	})
+
+	t.Run("non-proto request passes through", func(t *testing.T) {
+		called = false
+		_, err := interceptor(context.Background(), "plain", info, handler)
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+}
+
+// fakeStream delivers msgs in order from RecvMsg
+type fakeStream struct {
+	grpc.ServerStream
+	msgs []proto.Message
+}
+
+func (s *fakeStream) RecvMsg(m interface{}) error {
+	next := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	proto.Merge(m.(proto.Message), next)
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	md := requestDescriptor(t)
+	valid := newRequest(md, uuid.New().String())
+	invalid := newRequest(md, "00000000-0000-0000-0000-000000000000")
+	stream := &fakeStream{msgs: []proto.Message{valid, invalid}}
+
+	err := StreamServerInterceptor()(nil, stream, &grpc.StreamServerInfo{}, func(srv interface{}, ss grpc.ServerStream) error {
+		first := dynamicpb.NewMessage(md)
+		require.NoError(t, ss.RecvMsg(first))
+		assert.True(t, proto.Equal(valid, first))
+
+		second := dynamicpb.NewMessage(md)
+		return ss.RecvMsg(second)
+	})
+	assertInvalidArgument(t, err)
+}
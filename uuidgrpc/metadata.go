@@ -0,0 +1,38 @@
+package uuidgrpc
+
+import (
+	"context"
+
+	"github.com/alex-held/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataOp is the ValidationError operation reported by ExtractUUID
+const metadataOp = "gRPC metadata extraction"
+
+// InjectUUID returns a copy of ctx whose outgoing metadata carries u under
+// key. Zero UUIDs are not sent and ctx is returned unchanged.
+func InjectUUID(ctx context.Context, key string, u uuid.ValidatedUUID) context.Context {
+	if u.IsZero() {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, key, u.String())
+}
+
+// ExtractUUID parses the UUID stored under key in the incoming metadata of
+// ctx. A missing key reports uuid.ErrEmpty; when the key holds several values
+// the first one is used. Errors are *uuid.ValidationError with Field set to key.
+func ExtractUUID(ctx context.Context, key string) (uuid.ValidatedUUID, error) {
+	var value string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(key); len(values) > 0 {
+			value = values[0]
+		}
+	}
+
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.ValidatedUUID{}, &uuid.ValidationError{Op: metadataOp, Field: key, Err: err}
+	}
+	return u, nil
+}
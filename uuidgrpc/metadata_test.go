@@ -0,0 +1,59 @@
+package uuidgrpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alex-held/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// outgoingToIncoming moves the outgoing metadata of ctx to the incoming side,
+// as a gRPC hop would
+func outgoingToIncoming(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestInjectExtractUUID(t *testing.T) {
+	id := uuid.New()
+
+	ctx := outgoingToIncoming(InjectUUID(context.Background(), "X-Tenant-ID", id))
+
+	got, err := ExtractUUID(ctx, "x-tenant-id")
+	require.NoError(t, err)
+	assert.Equal(t, id, got)
+}
+
+func TestInjectUUID_Zero(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, ctx, InjectUUID(ctx, "x-tenant-id", uuid.ValidatedUUID{}))
+}
+
+func TestExtractUUID_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{"no metadata", context.Background(), uuid.ErrEmpty},
+		{"missing key", metadata.NewIncomingContext(context.Background(), metadata.Pairs("other", "x")), uuid.ErrEmpty},
+		{"invalid", metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-tenant-id", "invalid")), uuid.ErrInvalidFormat},
+		{"nil", metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-tenant-id", "00000000-0000-0000-0000-000000000000")), uuid.ErrNil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ExtractUUID(tt.ctx, "x-tenant-id")
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.want)
+
+			var verr *uuid.ValidationError
+			require.True(t, errors.As(err, &verr))
+			assert.Equal(t, "x-tenant-id", verr.Field)
+		})
+	}
+}
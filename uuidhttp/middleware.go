@@ -0,0 +1,64 @@
+// Package uuidhttp provides net/http helpers for request correlation IDs and
+// for extracting UUIDs from requests.
+package uuidhttp
+
+import (
+	"net/http"
+
+	"github.com/alex-held/uuid"
+)
+
+// HeaderRequestID is the default header carrying the request ID
+const HeaderRequestID = "X-Request-ID"
+
+// Option configures the RequestID middleware
+type Option func(*config)
+
+// config holds the RequestID middleware settings
+type config struct {
+	header    string
+	onInvalid func(r *http.Request, value string, err error)
+}
+
+// WithHeader reads and echoes the request ID using header instead of
+// HeaderRequestID
+func WithHeader(header string) Option {
+	return func(c *config) {
+		c.header = header
+	}
+}
+
+// OnInvalid registers fn to observe inbound request IDs that failed
+// validation and were replaced. It runs before the next handler.
+func OnInvalid(fn func(r *http.Request, value string, err error)) Option {
+	return func(c *config) {
+		c.onInvalid = fn
+	}
+}
+
+// RequestID returns middleware that reads the request ID header, generating a
+// new ID when it is absent and replacing it when it is invalid. The ID is
+// stored in the request context via uuid.NewContext and echoed on the
+// response header before the next handler runs.
+func RequestID(opts ...Option) func(http.Handler) http.Handler {
+	cfg := config{header: HeaderRequestID}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value := r.Header.Get(cfg.header)
+			id, err := uuid.Parse(value)
+			if err != nil {
+				if value != "" && cfg.onInvalid != nil {
+					cfg.onInvalid(r, value, err)
+				}
+				id = uuid.New()
+			}
+
+			w.Header().Set(cfg.header, id.String())
+			next.ServeHTTP(w, r.WithContext(uuid.NewContext(r.Context(), id)))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package uuidhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alex-held/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serve runs req through the RequestID middleware and returns the response
+// and the request ID seen by the handler
+func serve(t *testing.T, req *http.Request, opts ...Option) (*httptest.ResponseRecorder, uuid.ValidatedUUID) {
+	t.Helper()
+
+	var seen uuid.ValidatedUUID
+	h := RequestID(opts...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, ok := uuid.FromContext(r.Context())
+		require.True(t, ok)
+		seen = id
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec, seen
+}
+
+func TestRequestID(t *testing.T) {
+	t.Run("keeps valid inbound ID", func(t *testing.T) {
+		id := uuid.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(HeaderRequestID, id.String())
+
+		rec, seen := serve(t, req)
+		assert.Equal(t, id, seen)
+		assert.Equal(t, id.String(), rec.Header().Get(HeaderRequestID))
+	})
+
+	t.Run("generates missing ID", func(t *testing.T) {
+		called := false
+		rec, seen := serve(t, httptest.NewRequest(http.MethodGet, "/", nil), OnInvalid(func(*http.Request, string, error) {
+			called = true
+		}))
+
+		assert.False(t, seen.IsZero())
+		assert.Equal(t, seen.String(), rec.Header().Get(HeaderRequestID))
+		assert.False(t, called)
+	})
+
+	t.Run("replaces invalid ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(HeaderRequestID, "invalid")
+
+		var got string
+		var gotErr error
+		rec, seen := serve(t, req, OnInvalid(func(_ *http.Request, value string, err error) {
+			got, gotErr = value, err
+		}))
+
+		assert.Equal(t, "invalid", got)
+		assert.ErrorIs(t, gotErr, uuid.ErrInvalidFormat)
+		assert.False(t, seen.IsZero())
+		assert.Equal(t, seen.String(), rec.Header().Get(HeaderRequestID))
+	})
+
+	t.Run("custom header", func(t *testing.T) {
+		id := uuid.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Correlation-ID", id.String())
+
+		rec, seen := serve(t, req, WithHeader("X-Correlation-ID"))
+		assert.Equal(t, id, seen)
+		assert.Equal(t, id.String(), rec.Header().Get("X-Correlation-ID"))
+		assert.Empty(t, rec.Header().Get(HeaderRequestID))
+	})
+}
@@ -0,0 +1,47 @@
+package uuidhttp
+
+import (
+	"net/http"
+
+	"github.com/alex-held/uuid"
+)
+
+// Operations reported in the ValidationError returned by the request helpers
+const (
+	OpPath   = "path parameter extraction"
+	OpQuery  = "query parameter extraction"
+	OpHeader = "header extraction"
+)
+
+// FromRequestPath parses the path wildcard name matched by http.ServeMux.
+// Errors are *uuid.ValidationError with Op OpPath and Field name.
+func FromRequestPath(r *http.Request, name string) (uuid.ValidatedUUID, error) {
+	return parse(OpPath, name, r.PathValue(name))
+}
+
+// FromQuery parses the first query parameter name. Errors are
+// *uuid.ValidationError with Op OpQuery and Field name.
+func FromQuery(r *http.Request, name string) (uuid.ValidatedUUID, error) {
+	return parse(OpQuery, name, r.URL.Query().Get(name))
+}
+
+// FromHeader parses the first value of header name. Errors are
+// *uuid.ValidationError with Op OpHeader and Field name.
+func FromHeader(r *http.Request, name string) (uuid.ValidatedUUID, error) {
+	return parse(OpHeader, name, r.Header.Get(name))
+}
+
+// BadRequest replies with 400 Bad Request and the error text, for handlers
+// rejecting a request after one of the extraction helpers failed
+func BadRequest(w http.ResponseWriter, err error) {
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
+
+// parse validates value, reporting failures as a ValidationError for field
+func parse(op, field, value string) (uuid.ValidatedUUID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.ValidatedUUID{}, &uuid.ValidationError{Op: op, Field: field, Err: err}
+	}
+	return u, nil
+}
@@ -0,0 +1,78 @@
+package uuidhttp
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alex-held/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromRequestPath(t *testing.T) {
+	id := uuid.New()
+
+	var got uuid.ValidatedUUID
+	var gotErr error
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		got, gotErr = FromRequestPath(r, "id")
+	})
+
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/"+id.String(), nil))
+	require.NoError(t, gotErr)
+	assert.Equal(t, id, got)
+
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/invalid", nil))
+	assert.ErrorIs(t, gotErr, uuid.ErrInvalidFormat)
+	assertRequestError(t, gotErr, OpPath, "id")
+}
+
+func TestFromQuery(t *testing.T) {
+	id := uuid.New()
+
+	got, err := FromQuery(httptest.NewRequest(http.MethodGet, "/?owner="+id.String(), nil), "owner")
+	require.NoError(t, err)
+	assert.Equal(t, id, got)
+
+	_, err = FromQuery(httptest.NewRequest(http.MethodGet, "/", nil), "owner")
+	assert.ErrorIs(t, err, uuid.ErrEmpty)
+	assertRequestError(t, err, OpQuery, "owner")
+}
+
+func TestFromHeader(t *testing.T) {
+	id := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Tenant-ID", id.String())
+
+	got, err := FromHeader(req, "X-Tenant-ID")
+	require.NoError(t, err)
+	assert.Equal(t, id, got)
+
+	req.Header.Set("X-Tenant-ID", "00000000-0000-0000-0000-000000000000")
+	_, err = FromHeader(req, "X-Tenant-ID")
+	assert.ErrorIs(t, err, uuid.ErrNil)
+	assertRequestError(t, err, OpHeader, "X-Tenant-ID")
+}
+
+func TestBadRequest(t *testing.T) {
+	_, err := FromQuery(httptest.NewRequest(http.MethodGet, "/?owner=invalid", nil), "owner")
+	require.Error(t, err)
+
+	rec := httptest.NewRecorder()
+	BadRequest(rec, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), `for field "owner"`)
+}
+
+// assertRequestError checks err is a ValidationError for op and field
+func assertRequestError(t *testing.T, err error, op, field string) {
+	t.Helper()
+
+	var verr *uuid.ValidationError
+	require.True(t, errors.As(err, &verr))
+	assert.Equal(t, op, verr.Op)
+	assert.Equal(t, field, verr.Field)
+}
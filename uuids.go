@@ -0,0 +1,101 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// UUIDs is a list of ValidatedUUIDs that marshals to a JSON array, converts
+// to and from repeated protobuf UUID fields and maps to a Postgres uuid[]
+// column
+type UUIDs []ValidatedUUID
+
+// MarshalJSON implements json.Marshaler, writing [] rather than null for an
+// empty list
+func (ids UUIDs) MarshalJSON() ([]byte, error) {
+	if ids == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]ValidatedUUID(ids))
+}
+
+// ToProtos converts ids to protobuf UUID messages, see UUIDsToProtos
+func (ids UUIDs) ToProtos() ([]*UUID, error) {
+	return UUIDsToProtos(ids)
+}
+
+// UUIDsFromProtos converts protobuf UUID messages to UUIDs, see ProtosToUUIDs
+func UUIDsFromProtos(pbs []*UUID) (UUIDs, error) {
+	return ProtosToUUIDs(pbs)
+}
+
+// Value implements driver.Valuer, writing a Postgres array literal such as
+// {a,b,c}. A nil list is written as NULL.
+func (ids UUIDs) Value() (driver.Value, error) {
+	if ids == nil {
+		return nil, nil
+	}
+
+	var b strings.Builder
+	b.Grow(len(ids)*37 + 2)
+	b.WriteByte('{')
+	for i, u := range ids {
+		if err := u.Validate(); err != nil {
+			return nil, validationFailed("database write", fmt.Errorf("index %d: %w", i, err))
+		}
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(u.UUID.String())
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Scan implements sql.Scanner for Postgres uuid[] values in array literal
+// form. NULL scans to a nil list; NULL elements are rejected.
+func (ids *UUIDs) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		*ids = nil
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into UUIDs", value)
+	}
+
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return validationFailed("database scan", invalidFormatf("not an array literal: %q", s))
+	}
+	body := s[1 : len(s)-1]
+	if body == "" {
+		*ids = UUIDs{}
+		return nil
+	}
+
+	elems := strings.Split(body, ",")
+	parsed := make(UUIDs, len(elems))
+	for i, elem := range elems {
+		elem = strings.TrimSpace(elem)
+		if len(elem) >= 2 && elem[0] == '"' && elem[len(elem)-1] == '"' {
+			elem = elem[1 : len(elem)-1]
+		}
+		if strings.EqualFold(elem, "NULL") {
+			return validationFailed("database scan", fmt.Errorf("index %d: %w", i, ErrNil))
+		}
+		u, err := Parse(elem)
+		if err != nil {
+			return validationFailed("database scan", fmt.Errorf("index %d: %w", i, err))
+		}
+		parsed[i] = u
+	}
+
+	*ids = parsed
+	return nil
+}
@@ -0,0 +1,108 @@
+package uuid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUIDs_JSON(t *testing.T) {
+	ids := UUIDs{
+		MustParse("550e8400-e29b-41d4-a716-446655440000"),
+		MustParse("01890a5d-ac96-774b-bcce-b302099a8057"),
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		data, err := json.Marshal(ids)
+		require.NoError(t, err)
+		assert.JSONEq(t, `["550e8400-e29b-41d4-a716-446655440000","01890a5d-ac96-774b-bcce-b302099a8057"]`, string(data))
+
+		var got UUIDs
+		require.NoError(t, json.Unmarshal(data, &got))
+		assert.Equal(t, ids, got)
+	})
+
+	t.Run("nil marshals as empty array", func(t *testing.T) {
+		data, err := json.Marshal(struct {
+			IDs UUIDs `json:"ids"`
+		}{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"ids":[]}`, string(data))
+	})
+
+	t.Run("invalid element fails", func(t *testing.T) {
+		var got UUIDs
+		assert.Error(t, json.Unmarshal([]byte(`["invalid"]`), &got))
+		_, err := json.Marshal(UUIDs{{}})
+		assert.Error(t, err)
+	})
+}
+
+func TestUUIDs_Proto(t *testing.T) {
+	ids := UUIDs{New(), New()}
+
+	pbs, err := ids.ToProtos()
+	require.NoError(t, err)
+	require.Len(t, pbs, 2)
+
+	got, err := UUIDsFromProtos(pbs)
+	require.NoError(t, err)
+	assert.Equal(t, ids, got)
+
+	_, err = UUIDsFromProtos([]*UUID{pbs[0], {Val: "invalid"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "index 1")
+
+	_, err = UUIDs{ids[0], {}}.ToProtos()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "index 1")
+}
+
+func TestUUIDs_SQL(t *testing.T) {
+	ids := UUIDs{
+		MustParse("550e8400-e29b-41d4-a716-446655440000"),
+		MustParse("01890a5d-ac96-774b-bcce-b302099a8057"),
+	}
+
+	t.Run("value", func(t *testing.T) {
+		v, err := ids.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "{550e8400-e29b-41d4-a716-446655440000,01890a5d-ac96-774b-bcce-b302099a8057}", v)
+
+		v, err = UUIDs{}.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "{}", v)
+
+		v, err = UUIDs(nil).Value()
+		require.NoError(t, err)
+		assert.Nil(t, v)
+
+		_, err = UUIDs{ids[0], {}}.Value()
+		assert.ErrorIs(t, err, ErrNil)
+	})
+
+	t.Run("scan", func(t *testing.T) {
+		var got UUIDs
+		require.NoError(t, got.Scan([]byte("{550e8400-e29b-41d4-a716-446655440000,01890a5d-ac96-774b-bcce-b302099a8057}")))
+		assert.Equal(t, ids, got)
+
+		require.NoError(t, got.Scan(`{"550e8400-e29b-41d4-a716-446655440000"}`))
+		assert.Equal(t, UUIDs{ids[0]}, got)
+
+		require.NoError(t, got.Scan("{}"))
+		assert.Equal(t, UUIDs{}, got)
+
+		require.NoError(t, got.Scan(nil))
+		assert.Nil(t, got)
+	})
+
+	t.Run("scan invalid fails", func(t *testing.T) {
+		var got UUIDs
+		assert.Error(t, got.Scan("550e8400-e29b-41d4-a716-446655440000"))
+		assert.ErrorIs(t, got.Scan("{550e8400-e29b-41d4-a716-446655440000,NULL}"), ErrNil)
+		assert.ErrorIs(t, got.Scan("{invalid}"), ErrInvalidFormat)
+		assert.Error(t, got.Scan(42))
+	})
+}
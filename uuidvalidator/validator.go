@@ -0,0 +1,51 @@
+// Package uuidvalidator integrates ValidatedUUID with go-playground/validator.
+package uuidvalidator
+
+import (
+	"reflect"
+
+	"github.com/alex-held/uuid"
+	"github.com/go-playground/validator/v10"
+)
+
+// Tag is the validation tag registered by Register
+const Tag = "validateduuid"
+
+// Register teaches v about this package's UUID types and adds the Tag
+// validation.
+//
+// ValidatedUUID, NullUUID and BinaryUUID fields are validated as their
+// canonical string, with zero and invalid values seen as the empty string, so
+// "required" rejects them. The Tag validation accepts string fields that Parse
+// accepts and the UUID types when they hold a non-zero UUID; combine it with
+// "omitempty" to allow unset values.
+func Register(v *validator.Validate) error {
+	v.RegisterCustomTypeFunc(fieldValue, uuid.ValidatedUUID{}, uuid.NullUUID{}, uuid.BinaryUUID{})
+	return v.RegisterValidation(Tag, isValidatedUUID)
+}
+
+// fieldValue maps the UUID types to the string value seen by validations
+func fieldValue(field reflect.Value) interface{} {
+	var u uuid.ValidatedUUID
+	switch v := field.Interface().(type) {
+	case uuid.ValidatedUUID:
+		u = v
+	case uuid.NullUUID:
+		if v.Valid {
+			u = v.UUID
+		}
+	case uuid.BinaryUUID:
+		u = v.ValidatedUUID
+	}
+
+	if u.Validate() != nil {
+		return ""
+	}
+	return u.String()
+}
+
+// isValidatedUUID implements the Tag validation
+func isValidatedUUID(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	return field.Kind() == reflect.String && uuid.IsValid(field.String())
+}
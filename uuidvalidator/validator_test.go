@@ -0,0 +1,82 @@
+package uuidvalidator
+
+import (
+	"testing"
+
+	"github.com/alex-held/uuid"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type request struct {
+	ID       uuid.ValidatedUUID  `validate:"required"`
+	ParentID uuid.ValidatedUUID  `validate:"omitempty,validateduuid"`
+	OwnerID  string              `validate:"required,validateduuid"`
+	Ref      uuid.NullUUID       `validate:"required"`
+	Key      uuid.BinaryUUID     `validate:"validateduuid"`
+	Alias    *uuid.ValidatedUUID `validate:"omitempty,validateduuid"`
+}
+
+// newValidate returns a validator with the package registered
+func newValidate(t *testing.T) *validator.Validate {
+	t.Helper()
+
+	v := validator.New()
+	require.NoError(t, Register(v))
+	return v
+}
+
+func validRequest() request {
+	return request{
+		ID:      uuid.New(),
+		OwnerID: uuid.New().String(),
+		Ref:     uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		Key:     uuid.BinaryUUID{ValidatedUUID: uuid.New()},
+	}
+}
+
+func TestRegister(t *testing.T) {
+	v := newValidate(t)
+
+	assert.NoError(t, v.Struct(validRequest()))
+
+	alias := uuid.New()
+	withOptional := validRequest()
+	withOptional.ParentID = uuid.New()
+	withOptional.Alias = &alias
+	assert.NoError(t, v.Struct(withOptional))
+}
+
+func TestRegister_Failures(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*request)
+		field  string
+		tag    string
+	}{
+		{"zero required", func(r *request) { r.ID = uuid.ValidatedUUID{} }, "ID", "required"},
+		{"invalid string", func(r *request) { r.OwnerID = "invalid" }, "OwnerID", Tag},
+		{"nil string", func(r *request) { r.OwnerID = "00000000-0000-0000-0000-000000000000" }, "OwnerID", Tag},
+		{"null required", func(r *request) { r.Ref = uuid.NullUUID{} }, "Ref", "required"},
+		{"zero binary", func(r *request) { r.Key = uuid.BinaryUUID{} }, "Key", Tag},
+		{"zero pointer", func(r *request) { r.Alias = &uuid.ValidatedUUID{} }, "Alias", Tag},
+	}
+
+	v := newValidate(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := validRequest()
+			tt.mutate(&r)
+
+			err := v.Struct(r)
+			require.Error(t, err)
+
+			var errs validator.ValidationErrors
+			require.ErrorAs(t, err, &errs)
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.field, errs[0].Field())
+			assert.Equal(t, tt.tag, errs[0].Tag())
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Package uuidzap provides zap fields for ValidatedUUID.
+package uuidzap
+
+import (
+	"github.com/alex-held/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Field returns a string field holding the canonical form of u. The zero value
+// logs as uuid.NilLogValue.
+func Field(key string, u uuid.ValidatedUUID) zap.Field {
+	return zap.String(key, value(u))
+}
+
+// Array returns an array field holding the canonical form of each element of
+// ids, with zero values logged as uuid.NilLogValue
+func Array(key string, ids []uuid.ValidatedUUID) zap.Field {
+	return zap.Array(key, uuids(ids))
+}
+
+// uuids implements zapcore.ArrayMarshaler
+type uuids []uuid.ValidatedUUID
+
+// MarshalLogArray implements zapcore.ArrayMarshaler
+func (ids uuids) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, u := range ids {
+		enc.AppendString(value(u))
+	}
+	return nil
+}
+
+// value returns the logged string for u
+func value(u uuid.ValidatedUUID) string {
+	if u.IsZero() {
+		return uuid.NilLogValue
+	}
+	return u.String()
+}
@@ -0,0 +1,41 @@
+package uuidzap
+
+import (
+	"testing"
+
+	"github.com/alex-held/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestField(t *testing.T) {
+	u := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	zap.New(core).Info("lookup",
+		Field("id", u),
+		Field("parent", uuid.ValidatedUUID{}),
+		Array("members", []uuid.ValidatedUUID{u, {}}),
+	)
+
+	entries := logs.All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, map[string]interface{}{
+		"id":      "550e8400-e29b-41d4-a716-446655440000",
+		"parent":  uuid.NilLogValue,
+		"members": []interface{}{"550e8400-e29b-41d4-a716-446655440000", uuid.NilLogValue},
+	}, entries[0].ContextMap())
+}
+
+func BenchmarkField(b *testing.B) {
+	u := uuid.New()
+	enc := zapcore.NewMapObjectEncoder()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Field("id", u).AddTo(enc)
+	}
+}
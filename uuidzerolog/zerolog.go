@@ -0,0 +1,37 @@
+// Package uuidzerolog provides zerolog helpers for ValidatedUUID.
+package uuidzerolog
+
+import (
+	"github.com/alex-held/uuid"
+	"github.com/rs/zerolog"
+)
+
+// Str adds the canonical form of u to e under key. The zero value logs as
+// uuid.NilLogValue.
+func Str(e *zerolog.Event, key string, u uuid.ValidatedUUID) *zerolog.Event {
+	return e.Str(key, value(u))
+}
+
+// Array adds the canonical form of each element of ids to e under key, with
+// zero values logged as uuid.NilLogValue
+func Array(e *zerolog.Event, key string, ids []uuid.ValidatedUUID) *zerolog.Event {
+	return e.Array(key, uuids(ids))
+}
+
+// uuids implements zerolog.LogArrayMarshaler
+type uuids []uuid.ValidatedUUID
+
+// MarshalZerologArray implements zerolog.LogArrayMarshaler
+func (ids uuids) MarshalZerologArray(a *zerolog.Array) {
+	for _, u := range ids {
+		a.Str(value(u))
+	}
+}
+
+// value returns the logged string for u
+func value(u uuid.ValidatedUUID) string {
+	if u.IsZero() {
+		return uuid.NilLogValue
+	}
+	return u.String()
+}
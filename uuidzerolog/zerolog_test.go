@@ -0,0 +1,30 @@
+package uuidzerolog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/alex-held/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrAndArray(t *testing.T) {
+	u := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+
+	e := logger.Info()
+	e = Str(e, "id", u)
+	e = Str(e, "parent", uuid.ValidatedUUID{})
+	Array(e, "members", []uuid.ValidatedUUID{u, {}}).Msg("lookup")
+
+	assert.JSONEq(t, `{
+		"level": "info",
+		"id": "550e8400-e29b-41d4-a716-446655440000",
+		"parent": "nil-uuid",
+		"members": ["550e8400-e29b-41d4-a716-446655440000", "nil-uuid"],
+		"message": "lookup"
+	}`, buf.String())
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -19,19 +21,25 @@ func New() ValidatedUUID {
 	return ValidatedUUID{UUID: uuid.New()}
 }
 
+// NewV7 creates a new time-ordered v7 ValidatedUUID, suitable for database
+// primary keys. Like New, it panics if the random source fails.
+func NewV7() ValidatedUUID {
+	return ValidatedUUID{UUID: uuid.Must(uuid.NewV7())}
+}
+
 // Parse parses a string into a ValidatedUUID with validation
 func Parse(s string) (ValidatedUUID, error) {
 	if s == "" {
-		return ValidatedUUID{}, fmt.Errorf("UUID cannot be empty")
+		return ValidatedUUID{}, ErrEmpty
 	}
 
 	parsed, err := uuid.Parse(s)
 	if err != nil {
-		return ValidatedUUID{}, fmt.Errorf("invalid UUID format: %w", err)
+		return ValidatedUUID{}, invalidFormat(err)
 	}
 
 	if parsed == uuid.Nil {
-		return ValidatedUUID{}, fmt.Errorf("UUID cannot be nil/zero value")
+		return ValidatedUUID{}, ErrNil
 	}
 
 	return ValidatedUUID{UUID: parsed}, nil
@@ -49,7 +57,7 @@ func MustParse(s string) ValidatedUUID {
 // FromGoogleUUID converts a google/uuid.UUID to our ValidatedUUID type
 func FromGoogleUUID(u uuid.UUID) (ValidatedUUID, error) {
 	if u == uuid.Nil {
-		return ValidatedUUID{}, fmt.Errorf("UUID cannot be nil/zero value")
+		return ValidatedUUID{}, ErrNil
 	}
 	return ValidatedUUID{UUID: u}, nil
 }
@@ -71,22 +79,26 @@ func (u ValidatedUUID) IsZero() bool {
 // Validate ensures the UUID is not zero and is properly formatted
 func (u ValidatedUUID) Validate() error {
 	if u.UUID == uuid.Nil {
-		return fmt.Errorf("UUID cannot be nil/zero value")
+		return ErrNil
 	}
 	return nil
 }
 
-// String returns the string representation of the UUID
+// String returns the string representation of the UUID in
+// DefaultStringFormat, or the canonical form when that is a short style
 func (u ValidatedUUID) String() string {
-	return u.UUID.String()
+	if !DefaultStringFormat.isParseable() {
+		return u.UUID.String()
+	}
+	return u.Formatted(DefaultStringFormat)
 }
 
 // MarshalJSON implements json.Marshaler with validation
 func (u ValidatedUUID) MarshalJSON() ([]byte, error) {
 	if err := u.Validate(); err != nil {
-		return nil, fmt.Errorf("UUID validation failed during JSON marshalling: %w", err)
+		return nil, validationFailed("JSON marshalling", err)
 	}
-	return json.Marshal(u.UUID.String())
+	return json.Marshal(u.String())
 }
 
 // UnmarshalJSON implements json.Unmarshaler with validation
@@ -98,40 +110,96 @@ func (u *ValidatedUUID) UnmarshalJSON(data []byte) error {
 
 	parsed, err := Parse(s)
 	if err != nil {
-		return fmt.Errorf("UUID validation failed during JSON unmarshalling: %w", err)
+		return validationFailed("JSON unmarshalling", err)
+	}
+
+	*u = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler with validation
+func (u ValidatedUUID) MarshalText() ([]byte, error) {
+	if err := u.Validate(); err != nil {
+		return nil, validationFailed("text marshalling", err)
+	}
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler with validation
+func (u *ValidatedUUID) UnmarshalText(data []byte) error {
+	parsed, err := Parse(string(data))
+	if err != nil {
+		return validationFailed("text unmarshalling", err)
 	}
 
 	*u = parsed
 	return nil
 }
 
+// MarshalBinary implements encoding.BinaryMarshaler with validation, producing
+// the raw 16 bytes
+func (u ValidatedUUID) MarshalBinary() ([]byte, error) {
+	if err := u.Validate(); err != nil {
+		return nil, validationFailed("binary marshalling", err)
+	}
+	b := u.UUID
+	return b[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler with validation
+func (u *ValidatedUUID) UnmarshalBinary(data []byte) error {
+	parsed, err := uuid.FromBytes(data)
+	if err != nil {
+		return validationFailed("binary unmarshalling", invalidFormat(err))
+	}
+
+	validated, err := FromGoogleUUID(parsed)
+	if err != nil {
+		return validationFailed("binary unmarshalling", err)
+	}
+
+	*u = validated
+	return nil
+}
+
 // Value implements driver.Valuer for database operations
 func (u ValidatedUUID) Value() (driver.Value, error) {
 	if err := u.Validate(); err != nil {
-		return nil, fmt.Errorf("UUID validation failed during database write: %w", err)
+		return nil, validationFailed("database write", err)
 	}
 	return u.UUID.String(), nil
 }
 
-// Scan implements sql.Scanner for database operations
+// Scan implements sql.Scanner for database operations. It accepts text in
+// any form Parse understands, raw 16-byte slices, uuid.UUID, [16]byte and
+// fmt.Stringer values.
 func (u *ValidatedUUID) Scan(value interface{}) error {
 	if value == nil {
-		return fmt.Errorf("UUID cannot be nil")
+		return ErrNil
 	}
 
-	var s string
+	var parsed ValidatedUUID
+	var err error
 	switch v := value.(type) {
 	case string:
-		s = v
+		parsed, err = Parse(v)
 	case []byte:
-		s = string(v)
+		if len(v) == 16 {
+			parsed, err = FromGoogleUUID(uuid.UUID(v))
+		} else {
+			parsed, err = Parse(string(v))
+		}
+	case uuid.UUID:
+		parsed, err = FromGoogleUUID(v)
+	case [16]byte:
+		parsed, err = FromGoogleUUID(v)
+	case fmt.Stringer:
+		parsed, err = Parse(v.String())
 	default:
 		return fmt.Errorf("cannot scan %T into UUID", value)
 	}
-
-	parsed, err := Parse(s)
 	if err != nil {
-		return fmt.Errorf("UUID validation failed during database scan: %w", err)
+		return validationFailed("database scan", err)
 	}
 
 	*u = parsed
@@ -141,7 +209,7 @@ func (u *ValidatedUUID) Scan(value interface{}) error {
 // ToProto converts the ValidatedUUID to a protobuf UUID message with validation
 func (u ValidatedUUID) ToProto() (*UUID, error) {
 	if err := u.Validate(); err != nil {
-		return nil, fmt.Errorf("UUID validation failed during protobuf marshalling: %w", err)
+		return nil, validationFailed("protobuf marshalling", err)
 	}
 	return &UUID{
 		Val: u.String(),
@@ -160,7 +228,7 @@ func (u ValidatedUUID) MustToProto() *UUID {
 // FromProto creates a ValidatedUUID from a protobuf UUID message
 func FromProto(pb *UUID) (ValidatedUUID, error) {
 	if pb == nil {
-		return ValidatedUUID{}, fmt.Errorf("protobuf UUID cannot be nil")
+		return ValidatedUUID{}, ErrNilProto
 	}
 	return Parse(pb.GetVal())
 }
@@ -174,10 +242,41 @@ func MustFromProto(pb *UUID) ValidatedUUID {
 	return u
 }
 
+// ToProtoBytes converts the ValidatedUUID to a protobuf UUIDBytes message with validation
+func (u ValidatedUUID) ToProtoBytes() (*UUIDBytes, error) {
+	if err := u.Validate(); err != nil {
+		return nil, validationFailed("protobuf marshalling", err)
+	}
+	b := u.UUID
+	return &UUIDBytes{
+		Val: b[:],
+	}, nil
+}
+
+// FromProtoBytes creates a ValidatedUUID from a protobuf UUIDBytes message with validation
+func FromProtoBytes(pb *UUIDBytes) (ValidatedUUID, error) {
+	if pb == nil {
+		return ValidatedUUID{}, ErrNilProto
+	}
+	parsed, err := uuid.FromBytes(pb.GetVal())
+	if err != nil {
+		return ValidatedUUID{}, invalidFormat(err)
+	}
+	return FromGoogleUUID(parsed)
+}
+
+// FromProtoPolicy creates a ValidatedUUID from a protobuf UUID message, enforcing opts
+func FromProtoPolicy(pb *UUID, opts ParseOptions) (ValidatedUUID, error) {
+	if pb == nil {
+		return ValidatedUUID{}, ErrNilProto
+	}
+	return ParseWithOptions(pb.GetVal(), opts)
+}
+
 // ToStringValue converts ValidatedUUID to protobuf StringValue with validation
 func (u ValidatedUUID) ToStringValue() (*wrapperspb.StringValue, error) {
 	if err := u.Validate(); err != nil {
-		return nil, fmt.Errorf("UUID validation failed: %w", err)
+		return nil, validationFailed("", err)
 	}
 	return wrapperspb.String(u.String()), nil
 }
@@ -189,3 +288,89 @@ func FromStringValue(sv *wrapperspb.StringValue) (ValidatedUUID, error) {
 	}
 	return Parse(sv.Value)
 }
+
+// ToBytesValue converts ValidatedUUID to protobuf BytesValue holding the raw 16 bytes, with validation
+func (u ValidatedUUID) ToBytesValue() (*wrapperspb.BytesValue, error) {
+	if err := u.Validate(); err != nil {
+		return nil, validationFailed("", err)
+	}
+	b := u.UUID
+	return wrapperspb.Bytes(b[:]), nil
+}
+
+// FromBytesValue creates ValidatedUUID from protobuf BytesValue holding the raw 16 bytes, with validation
+func FromBytesValue(bv *wrapperspb.BytesValue) (ValidatedUUID, error) {
+	if bv == nil {
+		return ValidatedUUID{}, fmt.Errorf("BytesValue cannot be nil")
+	}
+	parsed, err := uuid.FromBytes(bv.Value)
+	if err != nil {
+		return ValidatedUUID{}, invalidFormat(err)
+	}
+	return FromGoogleUUID(parsed)
+}
+
+// ToStructValue converts ValidatedUUID to a protobuf Struct string value with validation
+func (u ValidatedUUID) ToStructValue() (*structpb.Value, error) {
+	if err := u.Validate(); err != nil {
+		return nil, validationFailed("", err)
+	}
+	return structpb.NewStringValue(u.String()), nil
+}
+
+// FromStructValue creates ValidatedUUID from a protobuf Struct value, which must hold a string
+func FromStructValue(v *structpb.Value) (ValidatedUUID, error) {
+	if v == nil {
+		return ValidatedUUID{}, fmt.Errorf("struct Value cannot be nil")
+	}
+	sv, ok := v.GetKind().(*structpb.Value_StringValue)
+	if !ok {
+		return ValidatedUUID{}, fmt.Errorf("struct Value must hold a string, got %T", v.GetKind())
+	}
+	return Parse(sv.StringValue)
+}
+
+// ToAny packs the ValidatedUUID as a protobuf UUID message into an Any, with validation
+func (u ValidatedUUID) ToAny() (*anypb.Any, error) {
+	pb, err := u.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return anypb.New(pb)
+}
+
+// FromAnyProto unpacks a ValidatedUUID from an Any holding a UUID or UUIDBytes
+// message, with validation
+func FromAnyProto(a *anypb.Any) (ValidatedUUID, error) {
+	if a == nil {
+		return ValidatedUUID{}, fmt.Errorf("Any cannot be nil")
+	}
+
+	switch {
+	case a.MessageIs((*UUID)(nil)):
+		var pb UUID
+		if err := a.UnmarshalTo(&pb); err != nil {
+			return ValidatedUUID{}, fmt.Errorf("failed to unpack UUID: %w", err)
+		}
+		return FromProto(&pb)
+	case a.MessageIs((*UUIDBytes)(nil)):
+		var pb UUIDBytes
+		if err := a.UnmarshalTo(&pb); err != nil {
+			return ValidatedUUID{}, fmt.Errorf("failed to unpack UUIDBytes: %w", err)
+		}
+		return FromProtoBytes(&pb)
+	default:
+		return ValidatedUUID{}, fmt.Errorf("Any holds %s, not a UUID message", a.GetTypeUrl())
+	}
+}
+
+// CanonicalizeStringValue validates a protobuf StringValue holding a UUID and
+// rewrites its value to the canonical lowercase form in place
+func CanonicalizeStringValue(sv *wrapperspb.StringValue) error {
+	u, err := FromStringValue(sv)
+	if err != nil {
+		return err
+	}
+	sv.Value = u.UUID.String()
+	return nil
+}
@@ -0,0 +1,33 @@
+package uuid
+
+import "github.com/google/uuid"
+
+// Version returns the UUID version (0-15) as a plain int
+func (u ValidatedUUID) Version() int {
+	return int(u.UUID.Version())
+}
+
+// IsRFC4122 reports whether the UUID uses the RFC 4122 (RFC 9562) variant
+func (u ValidatedUUID) IsRFC4122() bool {
+	return u.UUID.Variant() == uuid.RFC4122
+}
+
+// IsV4 reports whether the UUID is a random v4 UUID
+func (u ValidatedUUID) IsV4() bool {
+	return u.IsRFC4122() && u.Version() == 4
+}
+
+// IsV7 reports whether the UUID is a Unix-time-ordered v7 UUID
+func (u ValidatedUUID) IsV7() bool {
+	return u.IsRFC4122() && u.Version() == 7
+}
+
+// IsTimeOrdered reports whether UUIDs of this version sort by creation time
+// (v6 and v7). v1 embeds a timestamp too, but its byte order does not sort.
+func (u ValidatedUUID) IsTimeOrdered() bool {
+	if !u.IsRFC4122() {
+		return false
+	}
+	v := u.Version()
+	return v == 6 || v == 7
+}
@@ -0,0 +1,42 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatedUUID_Version(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		version     int
+		rfc4122     bool
+		v4, v7      bool
+		timeOrdered bool
+	}{
+		{name: "v1", input: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", version: 1, rfc4122: true},
+		{name: "v4", input: "550e8400-e29b-41d4-a716-446655440000", version: 4, rfc4122: true, v4: true},
+		{name: "v6", input: "1d07decf-81d4-6fae-a765-00a0c91e6bf6", version: 6, rfc4122: true, timeOrdered: true},
+		{name: "v7", input: "01890a5d-ac96-774b-bcce-b302099a8057", version: 7, rfc4122: true, v7: true, timeOrdered: true},
+		{name: "v4 digit with Microsoft variant", input: "550e8400-e29b-41d4-c716-446655440000", version: 4},
+		{name: "v7 digit with NCS variant", input: "01890a5d-ac96-774b-3cce-b302099a8057", version: 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := MustParse(tt.input)
+			assert.Equal(t, tt.version, u.Version())
+			assert.Equal(t, tt.rfc4122, u.IsRFC4122())
+			assert.Equal(t, tt.v4, u.IsV4())
+			assert.Equal(t, tt.v7, u.IsV7())
+			assert.Equal(t, tt.timeOrdered, u.IsTimeOrdered())
+		})
+	}
+
+	t.Run("generated", func(t *testing.T) {
+		assert.True(t, New().IsV4())
+		assert.True(t, NewV7().IsV7())
+		assert.True(t, NewV7().IsTimeOrdered())
+	})
+}
@@ -0,0 +1,47 @@
+package uuid
+
+import "encoding/xml"
+
+// MarshalXML implements xml.Marshaler, writing the canonical form as the
+// element's character data
+func (u ValidatedUUID) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := u.Validate(); err != nil {
+		return validationFailed("XML marshalling", err)
+	}
+	return e.EncodeElement(u.String(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler with validation
+func (u *ValidatedUUID) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return validationFailed("XML unmarshalling", err)
+	}
+
+	*u = parsed
+	return nil
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr
+func (u ValidatedUUID) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if err := u.Validate(); err != nil {
+		return xml.Attr{}, validationFailed("XML marshalling", err)
+	}
+	return xml.Attr{Name: name, Value: u.String()}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr with validation
+func (u *ValidatedUUID) UnmarshalXMLAttr(attr xml.Attr) error {
+	parsed, err := Parse(attr.Value)
+	if err != nil {
+		return validationFailed("XML unmarshalling", err)
+	}
+
+	*u = parsed
+	return nil
+}
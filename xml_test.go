@@ -0,0 +1,61 @@
+package uuid
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type xmlOrder struct {
+	XMLName    xml.Name      `xml:"order"`
+	ID         ValidatedUUID `xml:"id,attr"`
+	CustomerID ValidatedUUID `xml:"customer"`
+}
+
+func TestValidatedUUID_XML(t *testing.T) {
+	id := MustParse("550e8400-e29b-41d4-a716-446655440000")
+	customer := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	doc := `<order id="550e8400-e29b-41d4-a716-446655440000"><customer>6ba7b810-9dad-11d1-80b4-00c04fd430c8</customer></order>`
+
+	t.Run("round trip", func(t *testing.T) {
+		out, err := xml.Marshal(xmlOrder{ID: id, CustomerID: customer})
+		require.NoError(t, err)
+		assert.Equal(t, doc, string(out))
+
+		var order xmlOrder
+		require.NoError(t, xml.Unmarshal(out, &order))
+		assert.Equal(t, id, order.ID)
+		assert.Equal(t, customer, order.CustomerID)
+	})
+
+	t.Run("marshal zero", func(t *testing.T) {
+		_, err := xml.Marshal(xmlOrder{CustomerID: customer})
+		assert.ErrorIs(t, err, ErrNil)
+
+		_, err = xml.Marshal(xmlOrder{ID: id})
+		assert.ErrorIs(t, err, ErrNil)
+	})
+
+	tests := []struct {
+		name string
+		doc  string
+		want error
+	}{
+		{"invalid attribute", `<order id="invalid"><customer>6ba7b810-9dad-11d1-80b4-00c04fd430c8</customer></order>`, ErrInvalidFormat},
+		{"nil element", `<order id="550e8400-e29b-41d4-a716-446655440000"><customer>00000000-0000-0000-0000-000000000000</customer></order>`, ErrNil},
+		{"empty element", `<order id="550e8400-e29b-41d4-a716-446655440000"><customer></customer></order>`, ErrEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var order xmlOrder
+			err := xml.Unmarshal([]byte(tt.doc), &order)
+			assert.ErrorIs(t, err, tt.want)
+
+			var verr *ValidationError
+			assert.ErrorAs(t, err, &verr)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package uuid
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2,
+// gopkg.in/yaml.v3 and goccy/go-yaml with the same validation as MarshalJSON
+func (u ValidatedUUID) MarshalYAML() (interface{}, error) {
+	if err := u.Validate(); err != nil {
+		return nil, validationFailed("YAML marshalling", err)
+	}
+	return u.String(), nil
+}
+
+// UnmarshalYAML implements the function-based yaml.Unmarshaler interface
+// understood by gopkg.in/yaml.v2, gopkg.in/yaml.v3 and goccy/go-yaml, with the
+// same validation as UnmarshalJSON. Decoders may skip it for explicit nulls,
+// leaving the zero value; call Validate afterwards to reject those.
+func (u *ValidatedUUID) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return validationFailed("YAML unmarshalling", invalidFormat(err))
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return validationFailed("YAML unmarshalling", err)
+	}
+
+	*u = parsed
+	return nil
+}
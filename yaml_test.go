@@ -0,0 +1,56 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type yamlConfig struct {
+	TenantID ValidatedUUID   `yaml:"tenant_id"`
+	Members  []ValidatedUUID `yaml:"members,omitempty"`
+}
+
+func TestValidatedUUID_YAML(t *testing.T) {
+	u := MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("round trip", func(t *testing.T) {
+		out, err := yaml.Marshal(yamlConfig{TenantID: u, Members: []ValidatedUUID{u}})
+		require.NoError(t, err)
+		assert.Equal(t, "tenant_id: 550e8400-e29b-41d4-a716-446655440000\nmembers:\n    - 550e8400-e29b-41d4-a716-446655440000\n", string(out))
+
+		var cfg yamlConfig
+		require.NoError(t, yaml.Unmarshal(out, &cfg))
+		assert.Equal(t, u, cfg.TenantID)
+		assert.Equal(t, []ValidatedUUID{u}, cfg.Members)
+	})
+
+	t.Run("marshal zero", func(t *testing.T) {
+		_, err := yaml.Marshal(yamlConfig{})
+		assert.ErrorIs(t, err, ErrNil)
+	})
+
+	tests := []struct {
+		name string
+		doc  string
+		want error
+	}{
+		{"empty", `tenant_id: ""`, ErrEmpty},
+		{"nil UUID", "tenant_id: 00000000-0000-0000-0000-000000000000", ErrNil},
+		{"invalid", "tenant_id: invalid", ErrInvalidFormat},
+		{"not a scalar", "tenant_id: [1, 2]", ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg yamlConfig
+			err := yaml.Unmarshal([]byte(tt.doc), &cfg)
+			assert.ErrorIs(t, err, tt.want)
+
+			var verr *ValidationError
+			assert.ErrorAs(t, err, &verr)
+		})
+	}
+}